@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/comment/v1/comment.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCommentRequestMultiError, or nil if none found.
+func (m *CreateCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := CreateCommentRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 2000 {
+		err := CreateCommentRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReplyTo() < 0 {
+		err := CreateCommentRequestValidationError{
+			field:  "ReplyTo",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCommentRequestMultiError) AllErrors() []error { return m }
+
+// CreateCommentRequestValidationError is the validation error returned by
+// CreateCommentRequest.Validate if the designated constraints aren't met.
+type CreateCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCommentRequestValidationError) ErrorName() string {
+	return "CreateCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCommentRequestValidationError{}
+
+// Validate checks the field values on CreateCommentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCommentReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCommentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCommentReplyMultiError, or nil if none found.
+func (m *CreateCommentReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCommentReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCommentReplyValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCommentReplyValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCommentReplyValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCommentReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCommentReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateCommentReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCommentReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCommentReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCommentReplyMultiError) AllErrors() []error { return m }
+
+// CreateCommentReplyValidationError is the validation error returned by
+// CreateCommentReply.Validate if the designated constraints aren't met.
+type CreateCommentReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCommentReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCommentReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCommentReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCommentReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCommentReplyValidationError) ErrorName() string {
+	return "CreateCommentReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCommentReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCommentReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCommentReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCommentReplyValidationError{}
+
+// Validate checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCommentRequestMultiError, or nil if none found.
+func (m *DeleteCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCid() < 1 {
+		err := DeleteCommentRequestValidationError{
+			field:  "Cid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCommentRequestValidationError is the validation error returned by
+// DeleteCommentRequest.Validate if the designated constraints aren't met.
+type DeleteCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentRequestValidationError) ErrorName() string {
+	return "DeleteCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentRequestValidationError{}
+
+// Validate checks the field values on DeleteCommentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCommentReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCommentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCommentReplyMultiError, or nil if none found.
+func (m *DeleteCommentReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCommentReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DeleteCommentReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCommentReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteCommentReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCommentReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCommentReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCommentReplyMultiError) AllErrors() []error { return m }
+
+// DeleteCommentReplyValidationError is the validation error returned by
+// DeleteCommentReply.Validate if the designated constraints aren't met.
+type DeleteCommentReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCommentReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCommentReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCommentReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCommentReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCommentReplyValidationError) ErrorName() string {
+	return "DeleteCommentReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCommentReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCommentReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCommentReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCommentReplyValidationError{}
+
+// Validate checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsRequestMultiError, or nil if none found.
+func (m *ListCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := ListCommentsRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListCommentsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 50 {
+		err := ListCommentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Type != nil {
+
+		if _, ok := _ListCommentsRequest_Type_InLookup[m.GetType()]; !ok {
+			err := ListCommentsRequestValidationError{
+				field:  "Type",
+				reason: "value must be in list [time like hot]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListCommentsRequestValidationError is the validation error returned by
+// ListCommentsRequest.Validate if the designated constraints aren't met.
+type ListCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsRequestValidationError) ErrorName() string {
+	return "ListCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsRequestValidationError{}
+
+var _ListCommentsRequest_Type_InLookup = map[string]struct{}{
+	"time": {},
+	"like": {},
+	"hot":  {},
+}
+
+// Validate checks the field values on ListCommentsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsReplyMultiError, or nil if none found.
+func (m *ListCommentsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentsReplyValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentsReplyValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentsReplyValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCommentsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListCommentsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListCommentsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsReplyMultiError) AllErrors() []error { return m }
+
+// ListCommentsReplyValidationError is the validation error returned by
+// ListCommentsReply.Validate if the designated constraints aren't met.
+type ListCommentsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsReplyValidationError) ErrorName() string {
+	return "ListCommentsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsReplyValidationError{}
+
+// Validate checks the field values on ListRepliesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRepliesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRepliesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRepliesRequestMultiError, or nil if none found.
+func (m *ListRepliesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRepliesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRootId() < 1 {
+		err := ListRepliesRequestValidationError{
+			field:  "RootId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListRepliesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 50 {
+		err := ListRepliesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRepliesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRepliesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRepliesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRepliesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRepliesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRepliesRequestMultiError) AllErrors() []error { return m }
+
+// ListRepliesRequestValidationError is the validation error returned by
+// ListRepliesRequest.Validate if the designated constraints aren't met.
+type ListRepliesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRepliesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRepliesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRepliesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRepliesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRepliesRequestValidationError) ErrorName() string {
+	return "ListRepliesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRepliesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRepliesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRepliesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRepliesRequestValidationError{}
+
+// Validate checks the field values on ListRepliesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRepliesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRepliesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRepliesReplyMultiError, or nil if none found.
+func (m *ListRepliesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRepliesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRepliesReplyValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRepliesReplyValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRepliesReplyValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRepliesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRepliesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRepliesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRepliesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRepliesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRepliesReplyMultiError) AllErrors() []error { return m }
+
+// ListRepliesReplyValidationError is the validation error returned by
+// ListRepliesReply.Validate if the designated constraints aren't met.
+type ListRepliesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRepliesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRepliesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRepliesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRepliesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRepliesReplyValidationError) ErrorName() string { return "ListRepliesReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRepliesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRepliesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRepliesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRepliesReplyValidationError{}
+
+// Validate checks the field values on LikeCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LikeCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeCommentRequestMultiError, or nil if none found.
+func (m *LikeCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCid() < 1 {
+		err := LikeCommentRequestValidationError{
+			field:  "Cid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _LikeCommentRequest_Like_InLookup[m.GetLike()]; !ok {
+		err := LikeCommentRequestValidationError{
+			field:  "Like",
+			reason: "value must be in list [0 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LikeCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeCommentRequestMultiError is an error wrapping multiple validation errors
+// returned by LikeCommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LikeCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeCommentRequestMultiError) AllErrors() []error { return m }
+
+// LikeCommentRequestValidationError is the validation error returned by
+// LikeCommentRequest.Validate if the designated constraints aren't met.
+type LikeCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeCommentRequestValidationError) ErrorName() string {
+	return "LikeCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LikeCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeCommentRequestValidationError{}
+
+var _LikeCommentRequest_Like_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+}
+
+// Validate checks the field values on LikeCommentReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LikeCommentReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeCommentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeCommentReplyMultiError, or nil if none found.
+func (m *LikeCommentReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeCommentReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LikeCommentReplyValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LikeCommentReplyValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LikeCommentReplyValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LikeCommentReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeCommentReplyMultiError is an error wrapping multiple validation errors
+// returned by LikeCommentReply.ValidateAll() if the designated constraints
+// aren't met.
+type LikeCommentReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeCommentReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeCommentReplyMultiError) AllErrors() []error { return m }
+
+// LikeCommentReplyValidationError is the validation error returned by
+// LikeCommentReply.Validate if the designated constraints aren't met.
+type LikeCommentReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeCommentReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeCommentReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeCommentReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeCommentReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeCommentReplyValidationError) ErrorName() string { return "LikeCommentReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LikeCommentReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeCommentReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeCommentReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeCommentReplyValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Cid
+
+	// no validation rules for Pid
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Uid
+
+	// no validation rules for Author
+
+	// no validation rules for Content
+
+	// no validation rules for RootId
+
+	// no validation rules for ReplyToUid
+
+	// no validation rules for LikeCount
+
+	// no validation rules for ReplyCount
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
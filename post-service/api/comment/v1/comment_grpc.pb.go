@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.30.1
+// source: api/comment/v1/comment.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CommentSrvClient is the client API for CommentSrv service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentSrvClient interface {
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentReply, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error)
+	// 分页获取帖子下的一级评论
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsReply, error)
+	// 分页获取一级评论下的全部回复，按时间正序
+	ListReplies(ctx context.Context, in *ListRepliesRequest, opts ...grpc.CallOption) (*ListRepliesReply, error)
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error)
+}
+
+type commentSrvClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentSrvClient(cc grpc.ClientConnInterface) CommentSrvClient {
+	return &commentSrvClient{cc}
+}
+
+func (c *commentSrvClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentReply, error) {
+	out := new(CreateCommentReply)
+	err := c.cc.Invoke(ctx, "/api.comment.v1.CommentSrv/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSrvClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentReply, error) {
+	out := new(DeleteCommentReply)
+	err := c.cc.Invoke(ctx, "/api.comment.v1.CommentSrv/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSrvClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsReply, error) {
+	out := new(ListCommentsReply)
+	err := c.cc.Invoke(ctx, "/api.comment.v1.CommentSrv/ListComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSrvClient) ListReplies(ctx context.Context, in *ListRepliesRequest, opts ...grpc.CallOption) (*ListRepliesReply, error) {
+	out := new(ListRepliesReply)
+	err := c.cc.Invoke(ctx, "/api.comment.v1.CommentSrv/ListReplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentSrvClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentReply, error) {
+	out := new(LikeCommentReply)
+	err := c.cc.Invoke(ctx, "/api.comment.v1.CommentSrv/LikeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentSrvServer is the server API for CommentSrv service.
+// All implementations must embed UnimplementedCommentSrvServer
+// for forward compatibility
+type CommentSrvServer interface {
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentReply, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error)
+	// 分页获取帖子下的一级评论
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsReply, error)
+	// 分页获取一级评论下的全部回复，按时间正序
+	ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesReply, error)
+	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error)
+	mustEmbedUnimplementedCommentSrvServer()
+}
+
+// UnimplementedCommentSrvServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentSrvServer struct {
+}
+
+func (UnimplementedCommentSrvServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCommentSrvServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCommentSrvServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedCommentSrvServer) ListReplies(context.Context, *ListRepliesRequest) (*ListRepliesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplies not implemented")
+}
+func (UnimplementedCommentSrvServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedCommentSrvServer) mustEmbedUnimplementedCommentSrvServer() {}
+
+// UnsafeCommentSrvServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentSrvServer will
+// result in compilation errors.
+type UnsafeCommentSrvServer interface {
+	mustEmbedUnimplementedCommentSrvServer()
+}
+
+func RegisterCommentSrvServer(s grpc.ServiceRegistrar, srv CommentSrvServer) {
+	s.RegisterService(&CommentSrv_ServiceDesc, srv)
+}
+
+func _CommentSrv_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSrvServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.comment.v1.CommentSrv/CreateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSrvServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSrv_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSrvServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.comment.v1.CommentSrv/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSrvServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSrv_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSrvServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.comment.v1.CommentSrv/ListComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSrvServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSrv_ListReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSrvServer).ListReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.comment.v1.CommentSrv/ListReplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSrvServer).ListReplies(ctx, req.(*ListRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentSrv_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentSrvServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.comment.v1.CommentSrv/LikeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentSrvServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentSrv_ServiceDesc is the grpc.ServiceDesc for CommentSrv service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentSrv_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.comment.v1.CommentSrv",
+	HandlerType: (*CommentSrvServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateComment",
+			Handler:    _CommentSrv_CreateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentSrv_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _CommentSrv_ListComments_Handler,
+		},
+		{
+			MethodName: "ListReplies",
+			Handler:    _CommentSrv_ListReplies_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _CommentSrv_LikeComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/comment/v1/comment.proto",
+}
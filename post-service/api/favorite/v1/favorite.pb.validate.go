@@ -0,0 +1,2212 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/favorite/v1/favorite.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AddFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddFavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddFavoriteRequestMultiError, or nil if none found.
+func (m *AddFavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddFavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := AddFavoriteRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCollectionId() < 0 {
+		err := AddFavoriteRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddFavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddFavoriteRequestMultiError is an error wrapping multiple validation errors
+// returned by AddFavoriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddFavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddFavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddFavoriteRequestMultiError) AllErrors() []error { return m }
+
+// AddFavoriteRequestValidationError is the validation error returned by
+// AddFavoriteRequest.Validate if the designated constraints aren't met.
+type AddFavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddFavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddFavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddFavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddFavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddFavoriteRequestValidationError) ErrorName() string {
+	return "AddFavoriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddFavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddFavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddFavoriteRequestValidationError{}
+
+// Validate checks the field values on AddFavoriteReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddFavoriteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddFavoriteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddFavoriteReplyMultiError, or nil if none found.
+func (m *AddFavoriteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddFavoriteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return AddFavoriteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddFavoriteReplyMultiError is an error wrapping multiple validation errors
+// returned by AddFavoriteReply.ValidateAll() if the designated constraints
+// aren't met.
+type AddFavoriteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddFavoriteReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddFavoriteReplyMultiError) AllErrors() []error { return m }
+
+// AddFavoriteReplyValidationError is the validation error returned by
+// AddFavoriteReply.Validate if the designated constraints aren't met.
+type AddFavoriteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddFavoriteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddFavoriteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddFavoriteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddFavoriteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddFavoriteReplyValidationError) ErrorName() string { return "AddFavoriteReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddFavoriteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddFavoriteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddFavoriteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddFavoriteReplyValidationError{}
+
+// Validate checks the field values on RemoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFavoriteRequestMultiError, or nil if none found.
+func (m *RemoveFavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := RemoveFavoriteRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveFavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveFavoriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFavoriteRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFavoriteRequestValidationError is the validation error returned by
+// RemoveFavoriteRequest.Validate if the designated constraints aren't met.
+type RemoveFavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFavoriteRequestValidationError) ErrorName() string {
+	return "RemoveFavoriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFavoriteRequestValidationError{}
+
+// Validate checks the field values on RemoveFavoriteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFavoriteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFavoriteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFavoriteReplyMultiError, or nil if none found.
+func (m *RemoveFavoriteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFavoriteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return RemoveFavoriteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveFavoriteReply.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveFavoriteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFavoriteReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFavoriteReplyMultiError) AllErrors() []error { return m }
+
+// RemoveFavoriteReplyValidationError is the validation error returned by
+// RemoveFavoriteReply.Validate if the designated constraints aren't met.
+type RemoveFavoriteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFavoriteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFavoriteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFavoriteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFavoriteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFavoriteReplyValidationError) ErrorName() string {
+	return "RemoveFavoriteReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFavoriteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFavoriteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFavoriteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFavoriteReplyValidationError{}
+
+// Validate checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesRequestMultiError, or nil if none found.
+func (m *ListFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListFavoritesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListFavoritesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.CollectionId != nil {
+
+		if m.GetCollectionId() < 0 {
+			err := ListFavoritesRequestValidationError{
+				field:  "CollectionId",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// ListFavoritesRequestValidationError is the validation error returned by
+// ListFavoritesRequest.Validate if the designated constraints aren't met.
+type ListFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesRequestValidationError) ErrorName() string {
+	return "ListFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesRequestValidationError{}
+
+// Validate checks the field values on ListFavoritesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesReplyMultiError, or nil if none found.
+func (m *ListFavoritesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetFavorites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFavoritesReplyValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFavoritesReplyValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFavoritesReplyValidationError{
+					field:  fmt.Sprintf("Favorites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFavoritesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListFavoritesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListFavoritesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesReplyMultiError) AllErrors() []error { return m }
+
+// ListFavoritesReplyValidationError is the validation error returned by
+// ListFavoritesReply.Validate if the designated constraints aren't met.
+type ListFavoritesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesReplyValidationError) ErrorName() string {
+	return "ListFavoritesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesReplyValidationError{}
+
+// Validate checks the field values on MoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveFavoriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveFavoriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveFavoriteRequestMultiError, or nil if none found.
+func (m *MoveFavoriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveFavoriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := MoveFavoriteRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCollectionId() < 0 {
+		err := MoveFavoriteRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveFavoriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveFavoriteRequestMultiError is an error wrapping multiple validation
+// errors returned by MoveFavoriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MoveFavoriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveFavoriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveFavoriteRequestMultiError) AllErrors() []error { return m }
+
+// MoveFavoriteRequestValidationError is the validation error returned by
+// MoveFavoriteRequest.Validate if the designated constraints aren't met.
+type MoveFavoriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveFavoriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveFavoriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveFavoriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveFavoriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveFavoriteRequestValidationError) ErrorName() string {
+	return "MoveFavoriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveFavoriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveFavoriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveFavoriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveFavoriteRequestValidationError{}
+
+// Validate checks the field values on MoveFavoriteReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveFavoriteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveFavoriteReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveFavoriteReplyMultiError, or nil if none found.
+func (m *MoveFavoriteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveFavoriteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return MoveFavoriteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveFavoriteReplyMultiError is an error wrapping multiple validation errors
+// returned by MoveFavoriteReply.ValidateAll() if the designated constraints
+// aren't met.
+type MoveFavoriteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveFavoriteReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveFavoriteReplyMultiError) AllErrors() []error { return m }
+
+// MoveFavoriteReplyValidationError is the validation error returned by
+// MoveFavoriteReply.Validate if the designated constraints aren't met.
+type MoveFavoriteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveFavoriteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveFavoriteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveFavoriteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveFavoriteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveFavoriteReplyValidationError) ErrorName() string {
+	return "MoveFavoriteReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveFavoriteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveFavoriteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveFavoriteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveFavoriteReplyValidationError{}
+
+// Validate checks the field values on CreateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionRequestMultiError, or nil if none found.
+func (m *CreateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateCollectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CreateCollectionRequestValidationError is the validation error returned by
+// CreateCollectionRequest.Validate if the designated constraints aren't met.
+type CreateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionRequestValidationError) ErrorName() string {
+	return "CreateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionRequestValidationError{}
+
+// Validate checks the field values on CreateCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionReplyMultiError, or nil if none found.
+func (m *CreateCollectionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCollectionReplyValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCollectionReplyValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCollectionReplyValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionReplyMultiError) AllErrors() []error { return m }
+
+// CreateCollectionReplyValidationError is the validation error returned by
+// CreateCollectionReply.Validate if the designated constraints aren't met.
+type CreateCollectionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionReplyValidationError) ErrorName() string {
+	return "CreateCollectionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionReplyValidationError{}
+
+// Validate checks the field values on RenameCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameCollectionRequestMultiError, or nil if none found.
+func (m *RenameCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() < 1 {
+		err := RenameCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := RenameCollectionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameCollectionRequestMultiError) AllErrors() []error { return m }
+
+// RenameCollectionRequestValidationError is the validation error returned by
+// RenameCollectionRequest.Validate if the designated constraints aren't met.
+type RenameCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameCollectionRequestValidationError) ErrorName() string {
+	return "RenameCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameCollectionRequestValidationError{}
+
+// Validate checks the field values on RenameCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameCollectionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameCollectionReplyMultiError, or nil if none found.
+func (m *RenameCollectionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameCollectionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return RenameCollectionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameCollectionReplyMultiError is an error wrapping multiple validation
+// errors returned by RenameCollectionReply.ValidateAll() if the designated
+// constraints aren't met.
+type RenameCollectionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameCollectionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameCollectionReplyMultiError) AllErrors() []error { return m }
+
+// RenameCollectionReplyValidationError is the validation error returned by
+// RenameCollectionReply.Validate if the designated constraints aren't met.
+type RenameCollectionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameCollectionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameCollectionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameCollectionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameCollectionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameCollectionReplyValidationError) ErrorName() string {
+	return "RenameCollectionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameCollectionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameCollectionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameCollectionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameCollectionReplyValidationError{}
+
+// Validate checks the field values on DeleteCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionRequestMultiError, or nil if none found.
+func (m *DeleteCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() < 1 {
+		err := DeleteCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionRequestValidationError is the validation error returned by
+// DeleteCollectionRequest.Validate if the designated constraints aren't met.
+type DeleteCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionRequestValidationError) ErrorName() string {
+	return "DeleteCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionRequestValidationError{}
+
+// Validate checks the field values on DeleteCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionReplyMultiError, or nil if none found.
+func (m *DeleteCollectionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DeleteCollectionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionReplyMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionReplyValidationError is the validation error returned by
+// DeleteCollectionReply.Validate if the designated constraints aren't met.
+type DeleteCollectionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionReplyValidationError) ErrorName() string {
+	return "DeleteCollectionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionReplyValidationError{}
+
+// Validate checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsRequestMultiError, or nil if none found.
+func (m *ListCollectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListCollectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsRequestMultiError) AllErrors() []error { return m }
+
+// ListCollectionsRequestValidationError is the validation error returned by
+// ListCollectionsRequest.Validate if the designated constraints aren't met.
+type ListCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsRequestValidationError) ErrorName() string {
+	return "ListCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsRequestValidationError{}
+
+// Validate checks the field values on ListCollectionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsReplyMultiError, or nil if none found.
+func (m *ListCollectionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCollectionsReplyValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCollectionsReplyValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCollectionsReplyValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsReplyMultiError) AllErrors() []error { return m }
+
+// ListCollectionsReplyValidationError is the validation error returned by
+// ListCollectionsReply.Validate if the designated constraints aren't met.
+type ListCollectionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsReplyValidationError) ErrorName() string {
+	return "ListCollectionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsReplyValidationError{}
+
+// Validate checks the field values on Favorite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Favorite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Favorite with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavoriteMultiError, or nil
+// if none found.
+func (m *Favorite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Favorite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pid
+
+	// no validation rules for CollectionId
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FavoriteValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FavoriteValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FavoriteValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FavoriteValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FavoriteValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FavoriteValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return FavoriteMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavoriteMultiError is an error wrapping multiple validation errors returned
+// by Favorite.ValidateAll() if the designated constraints aren't met.
+type FavoriteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavoriteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavoriteMultiError) AllErrors() []error { return m }
+
+// FavoriteValidationError is the validation error returned by
+// Favorite.Validate if the designated constraints aren't met.
+type FavoriteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavoriteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavoriteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavoriteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavoriteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavoriteValidationError) ErrorName() string { return "FavoriteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavoriteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavorite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavoriteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavoriteValidationError{}
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CollectionId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FavoriteCount
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
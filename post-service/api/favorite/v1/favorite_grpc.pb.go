@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v6.30.1
+// source: api/favorite/v1/favorite.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FavoriteSrvClient is the client API for FavoriteSrv service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FavoriteSrvClient interface {
+	// 收藏帖子，collection_id为0时放入未分类
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteReply, error)
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteReply, error)
+	// 按收藏时间倒序列出收藏，已删除的帖子标记为removed
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesReply, error)
+	// 将收藏移动到另一个收藏夹，collection_id为0时移回未分类
+	MoveFavorite(ctx context.Context, in *MoveFavoriteRequest, opts ...grpc.CallOption) (*MoveFavoriteReply, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionReply, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionReply, error)
+	// 删除收藏夹，其中的收藏移回未分类
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionReply, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsReply, error)
+}
+
+type favoriteSrvClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFavoriteSrvClient(cc grpc.ClientConnInterface) FavoriteSrvClient {
+	return &favoriteSrvClient{cc}
+}
+
+func (c *favoriteSrvClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteReply, error) {
+	out := new(AddFavoriteReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/AddFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteReply, error) {
+	out := new(RemoveFavoriteReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/RemoveFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesReply, error) {
+	out := new(ListFavoritesReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/ListFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) MoveFavorite(ctx context.Context, in *MoveFavoriteRequest, opts ...grpc.CallOption) (*MoveFavoriteReply, error) {
+	out := new(MoveFavoriteReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/MoveFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionReply, error) {
+	out := new(CreateCollectionReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionReply, error) {
+	out := new(RenameCollectionReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionReply, error) {
+	out := new(DeleteCollectionReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/DeleteCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *favoriteSrvClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsReply, error) {
+	out := new(ListCollectionsReply)
+	err := c.cc.Invoke(ctx, "/api.favorite.v1.FavoriteSrv/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FavoriteSrvServer is the server API for FavoriteSrv service.
+// All implementations must embed UnimplementedFavoriteSrvServer
+// for forward compatibility
+type FavoriteSrvServer interface {
+	// 收藏帖子，collection_id为0时放入未分类
+	AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteReply, error)
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteReply, error)
+	// 按收藏时间倒序列出收藏，已删除的帖子标记为removed
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesReply, error)
+	// 将收藏移动到另一个收藏夹，collection_id为0时移回未分类
+	MoveFavorite(context.Context, *MoveFavoriteRequest) (*MoveFavoriteReply, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionReply, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionReply, error)
+	// 删除收藏夹，其中的收藏移回未分类
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionReply, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsReply, error)
+	mustEmbedUnimplementedFavoriteSrvServer()
+}
+
+// UnimplementedFavoriteSrvServer must be embedded to have forward compatible implementations.
+type UnimplementedFavoriteSrvServer struct {
+}
+
+func (UnimplementedFavoriteSrvServer) AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedFavoriteSrvServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedFavoriteSrvServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedFavoriteSrvServer) MoveFavorite(context.Context, *MoveFavoriteRequest) (*MoveFavoriteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFavorite not implemented")
+}
+func (UnimplementedFavoriteSrvServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedFavoriteSrvServer) RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedFavoriteSrvServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedFavoriteSrvServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedFavoriteSrvServer) mustEmbedUnimplementedFavoriteSrvServer() {}
+
+// UnsafeFavoriteSrvServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FavoriteSrvServer will
+// result in compilation errors.
+type UnsafeFavoriteSrvServer interface {
+	mustEmbedUnimplementedFavoriteSrvServer()
+}
+
+func RegisterFavoriteSrvServer(s grpc.ServiceRegistrar, srv FavoriteSrvServer) {
+	s.RegisterService(&FavoriteSrv_ServiceDesc, srv)
+}
+
+func _FavoriteSrv_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/AddFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/RemoveFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/ListFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_MoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).MoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/MoveFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).MoveFavorite(ctx, req.(*MoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/DeleteCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FavoriteSrv_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FavoriteSrvServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.favorite.v1.FavoriteSrv/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FavoriteSrvServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FavoriteSrv_ServiceDesc is the grpc.ServiceDesc for FavoriteSrv service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FavoriteSrv_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.favorite.v1.FavoriteSrv",
+	HandlerType: (*FavoriteSrvServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddFavorite",
+			Handler:    _FavoriteSrv_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _FavoriteSrv_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _FavoriteSrv_ListFavorites_Handler,
+		},
+		{
+			MethodName: "MoveFavorite",
+			Handler:    _FavoriteSrv_MoveFavorite_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _FavoriteSrv_CreateCollection_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _FavoriteSrv_RenameCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _FavoriteSrv_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _FavoriteSrv_ListCollections_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/favorite/v1/favorite.proto",
+}
@@ -79,6 +79,17 @@ func (m *CreatePostRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetBoardId() < 1 {
+		err := CreatePostRequestValidationError{
+			field:  "BoardId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreatePostRequestMultiError(errors)
 	}
@@ -334,6 +345,17 @@ func (m *UpdatePostRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetVersion() < 1 {
+		err := UpdatePostRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.Title != nil {
 		// no validation rules for Title
 	}
@@ -357,10 +379,6 @@ func (m *UpdatePostRequest) validate(all bool) error {
 
 	}
 
-	if m.Score != nil {
-		// no validation rules for Score
-	}
-
 	if len(errors) > 0 {
 		return UpdatePostRequestMultiError(errors)
 	}
@@ -1037,6 +1055,258 @@ var _ interface {
 	ErrorName() string
 } = GetPostPreviewReplyValidationError{}
 
+// Validate checks the field values on BatchGetPostPreviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPostPreviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetPostPreviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPostPreviewsRequestMultiError, or nil if none found.
+func (m *BatchGetPostPreviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetPostPreviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetPids()); l < 1 || l > 100 {
+		err := BatchGetPostPreviewsRequestValidationError{
+			field:  "Pids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGetPostPreviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetPostPreviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchGetPostPreviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchGetPostPreviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetPostPreviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetPostPreviewsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetPostPreviewsRequestValidationError is the validation error returned
+// by BatchGetPostPreviewsRequest.Validate if the designated constraints
+// aren't met.
+type BatchGetPostPreviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetPostPreviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetPostPreviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetPostPreviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetPostPreviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetPostPreviewsRequestValidationError) ErrorName() string {
+	return "BatchGetPostPreviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetPostPreviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetPostPreviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetPostPreviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetPostPreviewsRequestValidationError{}
+
+// Validate checks the field values on BatchGetPostPreviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetPostPreviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetPostPreviewsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetPostPreviewsReplyMultiError, or nil if none found.
+func (m *BatchGetPostPreviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetPostPreviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetPostPreviewsReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetPostPreviewsReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetPostPreviewsReplyValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetPostPreviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetPostPreviewsReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetPostPreviewsReply.ValidateAll() if the
+// designated constraints aren't met.
+type BatchGetPostPreviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetPostPreviewsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetPostPreviewsReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetPostPreviewsReplyValidationError is the validation error returned by
+// BatchGetPostPreviewsReply.Validate if the designated constraints aren't met.
+type BatchGetPostPreviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetPostPreviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetPostPreviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetPostPreviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetPostPreviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetPostPreviewsReplyValidationError) ErrorName() string {
+	return "BatchGetPostPreviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetPostPreviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetPostPreviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetPostPreviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetPostPreviewsReplyValidationError{}
+
 // Validate checks the field values on GetPostDetailRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1331,6 +1601,25 @@ func (m *ListPostPreviewRequest) validate(all bool) error {
 		// no validation rules for Type
 	}
 
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.BoardId != nil {
+
+		if m.GetBoardId() < 1 {
+			err := ListPostPreviewRequestValidationError{
+				field:  "BoardId",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListPostPreviewRequestMultiError(errors)
 	}
@@ -1469,6 +1758,8 @@ func (m *ListPostPreviewReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return ListPostPreviewReplyMultiError(errors)
 	}
@@ -1809,33 +2100,10228 @@ var _ interface {
 	ErrorName() string
 } = AddPostLikeReplyValidationError{}
 
-// Validate checks the field values on Post with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Post) Validate() error {
+// Validate checks the field values on ListMyLikedPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyLikedPostsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Post with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in PostMultiError, or nil if none found.
-func (m *Post) ValidateAll() error {
+// ValidateAll checks the field values on ListMyLikedPostsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyLikedPostsRequestMultiError, or nil if none found.
+func (m *ListMyLikedPostsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Post) validate(all bool) error {
+func (m *ListMyLikedPostsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetPage() < 0 {
+		err := ListMyLikedPostsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Pid
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListMyLikedPostsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetCreateTime()).(type) {
+	if len(errors) > 0 {
+		return ListMyLikedPostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyLikedPostsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyLikedPostsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyLikedPostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyLikedPostsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyLikedPostsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyLikedPostsRequestValidationError is the validation error returned by
+// ListMyLikedPostsRequest.Validate if the designated constraints aren't met.
+type ListMyLikedPostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyLikedPostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyLikedPostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyLikedPostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyLikedPostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyLikedPostsRequestValidationError) ErrorName() string {
+	return "ListMyLikedPostsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyLikedPostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyLikedPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyLikedPostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyLikedPostsRequestValidationError{}
+
+// Validate checks the field values on ListMyLikedPostsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyLikedPostsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyLikedPostsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyLikedPostsReplyMultiError, or nil if none found.
+func (m *ListMyLikedPostsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyLikedPostsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMyLikedPostsReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMyLikedPostsReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMyLikedPostsReplyValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMyLikedPostsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyLikedPostsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListMyLikedPostsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyLikedPostsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyLikedPostsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyLikedPostsReplyMultiError) AllErrors() []error { return m }
+
+// ListMyLikedPostsReplyValidationError is the validation error returned by
+// ListMyLikedPostsReply.Validate if the designated constraints aren't met.
+type ListMyLikedPostsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyLikedPostsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyLikedPostsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyLikedPostsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyLikedPostsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyLikedPostsReplyValidationError) ErrorName() string {
+	return "ListMyLikedPostsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyLikedPostsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyLikedPostsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyLikedPostsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyLikedPostsReplyValidationError{}
+
+// Validate checks the field values on ListLeaderboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaderboardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaderboardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaderboardRequestMultiError, or nil if none found.
+func (m *ListLeaderboardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaderboardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListLeaderboardRequest_Period_InLookup[m.GetPeriod()]; !ok {
+		err := ListLeaderboardRequestValidationError{
+			field:  "Period",
+			reason: "value must be in list [day week all]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListLeaderboardRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListLeaderboardRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListLeaderboardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaderboardRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLeaderboardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaderboardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaderboardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaderboardRequestMultiError) AllErrors() []error { return m }
+
+// ListLeaderboardRequestValidationError is the validation error returned by
+// ListLeaderboardRequest.Validate if the designated constraints aren't met.
+type ListLeaderboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaderboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaderboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaderboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaderboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaderboardRequestValidationError) ErrorName() string {
+	return "ListLeaderboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaderboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaderboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaderboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaderboardRequestValidationError{}
+
+var _ListLeaderboardRequest_Period_InLookup = map[string]struct{}{
+	"day":  {},
+	"week": {},
+	"all":  {},
+}
+
+// Validate checks the field values on ListLeaderboardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeaderboardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeaderboardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeaderboardReplyMultiError, or nil if none found.
+func (m *ListLeaderboardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeaderboardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaderboardReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeaderboardReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeaderboardReplyValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLeaderboardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeaderboardReplyMultiError is an error wrapping multiple validation
+// errors returned by ListLeaderboardReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeaderboardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeaderboardReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeaderboardReplyMultiError) AllErrors() []error { return m }
+
+// ListLeaderboardReplyValidationError is the validation error returned by
+// ListLeaderboardReply.Validate if the designated constraints aren't met.
+type ListLeaderboardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeaderboardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeaderboardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeaderboardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeaderboardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeaderboardReplyValidationError) ErrorName() string {
+	return "ListLeaderboardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeaderboardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeaderboardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeaderboardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeaderboardReplyValidationError{}
+
+// Validate checks the field values on ListPostsByTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPostsByTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPostsByTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPostsByTagRequestMultiError, or nil if none found.
+func (m *ListPostsByTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPostsByTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTag()); l < 1 || l > 64 {
+		err := ListPostsByTagRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListPostsByTagRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListPostsByTagRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPostsByTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPostsByTagRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPostsByTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPostsByTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPostsByTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPostsByTagRequestMultiError) AllErrors() []error { return m }
+
+// ListPostsByTagRequestValidationError is the validation error returned by
+// ListPostsByTagRequest.Validate if the designated constraints aren't met.
+type ListPostsByTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPostsByTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPostsByTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPostsByTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPostsByTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPostsByTagRequestValidationError) ErrorName() string {
+	return "ListPostsByTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPostsByTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPostsByTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPostsByTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPostsByTagRequestValidationError{}
+
+// Validate checks the field values on ListPostsByTagReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPostsByTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPostsByTagReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPostsByTagReplyMultiError, or nil if none found.
+func (m *ListPostsByTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPostsByTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPostsByTagReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPostsByTagReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPostsByTagReplyValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPostsByTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPostsByTagReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPostsByTagReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPostsByTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPostsByTagReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPostsByTagReplyMultiError) AllErrors() []error { return m }
+
+// ListPostsByTagReplyValidationError is the validation error returned by
+// ListPostsByTagReply.Validate if the designated constraints aren't met.
+type ListPostsByTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPostsByTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPostsByTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPostsByTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPostsByTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPostsByTagReplyValidationError) ErrorName() string {
+	return "ListPostsByTagReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPostsByTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPostsByTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPostsByTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPostsByTagReplyValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPrefix()) > 64 {
+		err := ListTagsRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListTagsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListTagsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on ListTagsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTagsReplyMultiError, or
+// nil if none found.
+func (m *ListTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsReplyValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTagsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsReplyMultiError) AllErrors() []error { return m }
+
+// ListTagsReplyValidationError is the validation error returned by
+// ListTagsReply.Validate if the designated constraints aren't met.
+type ListTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsReplyValidationError) ErrorName() string { return "ListTagsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsReplyValidationError{}
+
+// Validate checks the field values on ListTrendingTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingTagsRequestMultiError, or nil if none found.
+func (m *ListTrendingTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetDays(); val < 1 || val > 7 {
+		err := ListTrendingTagsRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range [1, 7]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 50 {
+		err := ListTrendingTagsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrendingTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTrendingTagsRequestValidationError is the validation error returned by
+// ListTrendingTagsRequest.Validate if the designated constraints aren't met.
+type ListTrendingTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingTagsRequestValidationError) ErrorName() string {
+	return "ListTrendingTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingTagsRequestValidationError{}
+
+// Validate checks the field values on ListTrendingTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingTagsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingTagsReplyMultiError, or nil if none found.
+func (m *ListTrendingTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrendingTagsReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrendingTagsReplyValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrendingTagsReplyValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingTagsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingTagsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrendingTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingTagsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingTagsReplyMultiError) AllErrors() []error { return m }
+
+// ListTrendingTagsReplyValidationError is the validation error returned by
+// ListTrendingTagsReply.Validate if the designated constraints aren't met.
+type ListTrendingTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingTagsReplyValidationError) ErrorName() string {
+	return "ListTrendingTagsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingTagsReplyValidationError{}
+
+// Validate checks the field values on SearchPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchPostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPostsRequestMultiError, or nil if none found.
+func (m *SearchPostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 100 {
+		err := SearchPostsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := SearchPostsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 50 {
+		err := SearchPostsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPostsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPostsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPostsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchPostsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchPostsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchPostsRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Tag != nil {
+		// no validation rules for Tag
+	}
+
+	if m.Uid != nil {
+		// no validation rules for Uid
+	}
+
+	if len(errors) > 0 {
+		return SearchPostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPostsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchPostsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPostsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPostsRequestMultiError) AllErrors() []error { return m }
+
+// SearchPostsRequestValidationError is the validation error returned by
+// SearchPostsRequest.Validate if the designated constraints aren't met.
+type SearchPostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPostsRequestValidationError) ErrorName() string {
+	return "SearchPostsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchPostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPostsRequestValidationError{}
+
+// Validate checks the field values on SearchPostsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchPostsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchPostsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPostsReplyMultiError, or nil if none found.
+func (m *SearchPostsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchPostsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchPostsReplyValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchPostsReplyValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchPostsReplyValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchPostsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchPostsReplyMultiError is an error wrapping multiple validation errors
+// returned by SearchPostsReply.ValidateAll() if the designated constraints
+// aren't met.
+type SearchPostsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchPostsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchPostsReplyMultiError) AllErrors() []error { return m }
+
+// SearchPostsReplyValidationError is the validation error returned by
+// SearchPostsReply.Validate if the designated constraints aren't met.
+type SearchPostsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchPostsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchPostsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchPostsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchPostsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchPostsReplyValidationError) ErrorName() string { return "SearchPostsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchPostsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchPostsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchPostsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchPostsReplyValidationError{}
+
+// Validate checks the field values on SaveDraftRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SaveDraftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveDraftRequestMultiError, or nil if none found.
+func (m *SaveDraftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveDraftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDid() < 0 {
+		err := SaveDraftRequestValidationError{
+			field:  "Did",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 64 {
+		err := SaveDraftRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveDraftRequestValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveDraftRequestValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveDraftRequestValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetBoardId() < 0 {
+		err := SaveDraftRequestValidationError{
+			field:  "BoardId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SaveDraftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveDraftRequestMultiError is an error wrapping multiple validation errors
+// returned by SaveDraftRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SaveDraftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveDraftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveDraftRequestMultiError) AllErrors() []error { return m }
+
+// SaveDraftRequestValidationError is the validation error returned by
+// SaveDraftRequest.Validate if the designated constraints aren't met.
+type SaveDraftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveDraftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveDraftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveDraftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveDraftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveDraftRequestValidationError) ErrorName() string { return "SaveDraftRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveDraftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveDraftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveDraftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveDraftRequestValidationError{}
+
+// Validate checks the field values on SaveDraftReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SaveDraftReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveDraftReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SaveDraftReplyMultiError,
+// or nil if none found.
+func (m *SaveDraftReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveDraftReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetDraft()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SaveDraftReplyValidationError{
+					field:  "Draft",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SaveDraftReplyValidationError{
+					field:  "Draft",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDraft()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SaveDraftReplyValidationError{
+				field:  "Draft",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SaveDraftReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveDraftReplyMultiError is an error wrapping multiple validation errors
+// returned by SaveDraftReply.ValidateAll() if the designated constraints
+// aren't met.
+type SaveDraftReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveDraftReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveDraftReplyMultiError) AllErrors() []error { return m }
+
+// SaveDraftReplyValidationError is the validation error returned by
+// SaveDraftReply.Validate if the designated constraints aren't met.
+type SaveDraftReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveDraftReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveDraftReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveDraftReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveDraftReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveDraftReplyValidationError) ErrorName() string { return "SaveDraftReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveDraftReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveDraftReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveDraftReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveDraftReplyValidationError{}
+
+// Validate checks the field values on ListDraftsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListDraftsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDraftsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDraftsRequestMultiError, or nil if none found.
+func (m *ListDraftsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDraftsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListDraftsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListDraftsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDraftsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDraftsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListDraftsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListDraftsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDraftsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDraftsRequestMultiError) AllErrors() []error { return m }
+
+// ListDraftsRequestValidationError is the validation error returned by
+// ListDraftsRequest.Validate if the designated constraints aren't met.
+type ListDraftsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDraftsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDraftsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDraftsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDraftsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDraftsRequestValidationError) ErrorName() string {
+	return "ListDraftsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDraftsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDraftsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDraftsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDraftsRequestValidationError{}
+
+// Validate checks the field values on ListDraftsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListDraftsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDraftsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDraftsReplyMultiError, or nil if none found.
+func (m *ListDraftsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDraftsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetDrafts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDraftsReplyValidationError{
+						field:  fmt.Sprintf("Drafts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDraftsReplyValidationError{
+						field:  fmt.Sprintf("Drafts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDraftsReplyValidationError{
+					field:  fmt.Sprintf("Drafts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDraftsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDraftsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListDraftsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListDraftsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDraftsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDraftsReplyMultiError) AllErrors() []error { return m }
+
+// ListDraftsReplyValidationError is the validation error returned by
+// ListDraftsReply.Validate if the designated constraints aren't met.
+type ListDraftsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDraftsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDraftsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDraftsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDraftsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDraftsReplyValidationError) ErrorName() string { return "ListDraftsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListDraftsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDraftsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDraftsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDraftsReplyValidationError{}
+
+// Validate checks the field values on DeleteDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDraftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDraftRequestMultiError, or nil if none found.
+func (m *DeleteDraftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDraftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDid() < 1 {
+		err := DeleteDraftRequestValidationError{
+			field:  "Did",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteDraftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDraftRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteDraftRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteDraftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDraftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDraftRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDraftRequestValidationError is the validation error returned by
+// DeleteDraftRequest.Validate if the designated constraints aren't met.
+type DeleteDraftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDraftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDraftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDraftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDraftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDraftRequestValidationError) ErrorName() string {
+	return "DeleteDraftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDraftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDraftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDraftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDraftRequestValidationError{}
+
+// Validate checks the field values on DeleteDraftReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDraftReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDraftReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDraftReplyMultiError, or nil if none found.
+func (m *DeleteDraftReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDraftReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DeleteDraftReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDraftReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteDraftReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteDraftReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDraftReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDraftReplyMultiError) AllErrors() []error { return m }
+
+// DeleteDraftReplyValidationError is the validation error returned by
+// DeleteDraftReply.Validate if the designated constraints aren't met.
+type DeleteDraftReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDraftReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDraftReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDraftReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDraftReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDraftReplyValidationError) ErrorName() string { return "DeleteDraftReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteDraftReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDraftReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDraftReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDraftReplyValidationError{}
+
+// Validate checks the field values on PublishDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishDraftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishDraftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishDraftRequestMultiError, or nil if none found.
+func (m *PublishDraftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishDraftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDid() < 1 {
+		err := PublishDraftRequestValidationError{
+			field:  "Did",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PublishDraftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishDraftRequestMultiError is an error wrapping multiple validation
+// errors returned by PublishDraftRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PublishDraftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishDraftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishDraftRequestMultiError) AllErrors() []error { return m }
+
+// PublishDraftRequestValidationError is the validation error returned by
+// PublishDraftRequest.Validate if the designated constraints aren't met.
+type PublishDraftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishDraftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishDraftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishDraftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishDraftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishDraftRequestValidationError) ErrorName() string {
+	return "PublishDraftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishDraftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishDraftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishDraftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishDraftRequestValidationError{}
+
+// Validate checks the field values on PublishDraftReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PublishDraftReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishDraftReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishDraftReplyMultiError, or nil if none found.
+func (m *PublishDraftReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishDraftReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublishDraftReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublishDraftReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishDraftReplyValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PublishDraftReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishDraftReplyMultiError is an error wrapping multiple validation errors
+// returned by PublishDraftReply.ValidateAll() if the designated constraints
+// aren't met.
+type PublishDraftReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishDraftReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishDraftReplyMultiError) AllErrors() []error { return m }
+
+// PublishDraftReplyValidationError is the validation error returned by
+// PublishDraftReply.Validate if the designated constraints aren't met.
+type PublishDraftReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishDraftReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishDraftReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishDraftReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishDraftReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishDraftReplyValidationError) ErrorName() string {
+	return "PublishDraftReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishDraftReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishDraftReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishDraftReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishDraftReplyValidationError{}
+
+// Validate checks the field values on Draft with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Draft) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Draft with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DraftMultiError, or nil if none found.
+func (m *Draft) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Draft) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Did
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DraftValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DraftValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DraftValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DraftValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BoardId
+
+	if len(errors) > 0 {
+		return DraftMultiError(errors)
+	}
+
+	return nil
+}
+
+// DraftMultiError is an error wrapping multiple validation errors returned by
+// Draft.ValidateAll() if the designated constraints aren't met.
+type DraftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DraftMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DraftMultiError) AllErrors() []error { return m }
+
+// DraftValidationError is the validation error returned by Draft.Validate if
+// the designated constraints aren't met.
+type DraftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DraftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DraftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DraftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DraftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DraftValidationError) ErrorName() string { return "DraftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DraftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDraft.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DraftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DraftValidationError{}
+
+// Validate checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsRequestMultiError, or nil if none found.
+func (m *ListRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := ListRevisionsRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListRevisionsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListRevisionsRequestValidationError is the validation error returned by
+// ListRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsRequestValidationError) ErrorName() string {
+	return "ListRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRevisionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRevisionsReplyMultiError, or nil if none found.
+func (m *ListRevisionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRevisionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRevisionsReplyValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRevisionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRevisionsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRevisionsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRevisionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRevisionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRevisionsReplyMultiError) AllErrors() []error { return m }
+
+// ListRevisionsReplyValidationError is the validation error returned by
+// ListRevisionsReply.Validate if the designated constraints aren't met.
+type ListRevisionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRevisionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRevisionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRevisionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRevisionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRevisionsReplyValidationError) ErrorName() string {
+	return "ListRevisionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRevisionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRevisionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRevisionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRevisionsReplyValidationError{}
+
+// Validate checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionRequestMultiError, or nil if none found.
+func (m *GetRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := GetRevisionRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRev() < 1 {
+		err := GetRevisionRequestValidationError{
+			field:  "Rev",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevisionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetRevisionRequestValidationError is the validation error returned by
+// GetRevisionRequest.Validate if the designated constraints aren't met.
+type GetRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionRequestValidationError) ErrorName() string {
+	return "GetRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionRequestValidationError{}
+
+// Validate checks the field values on GetRevisionReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRevisionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevisionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevisionReplyMultiError, or nil if none found.
+func (m *GetRevisionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevisionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevisionReplyValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevisionReplyValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevisionReplyValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRevisionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevisionReplyMultiError is an error wrapping multiple validation errors
+// returned by GetRevisionReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetRevisionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevisionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevisionReplyMultiError) AllErrors() []error { return m }
+
+// GetRevisionReplyValidationError is the validation error returned by
+// GetRevisionReply.Validate if the designated constraints aren't met.
+type GetRevisionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevisionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevisionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevisionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevisionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevisionReplyValidationError) ErrorName() string { return "GetRevisionReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRevisionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevisionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevisionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevisionReplyValidationError{}
+
+// Validate checks the field values on DiffRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsRequestMultiError, or nil if none found.
+func (m *DiffRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := DiffRevisionsRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromRev() < 1 {
+		err := DiffRevisionsRequestValidationError{
+			field:  "FromRev",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToRev() < 1 {
+		err := DiffRevisionsRequestValidationError{
+			field:  "ToRev",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsRequestValidationError is the validation error returned by
+// DiffRevisionsRequest.Validate if the designated constraints aren't met.
+type DiffRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsRequestValidationError) ErrorName() string {
+	return "DiffRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffRevisionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffRevisionsReplyMultiError, or nil if none found.
+func (m *DiffRevisionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffRevisionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffRevisionsReplyValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffRevisionsReplyValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffRevisionsReplyValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffRevisionsReplyValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffRevisionsReplyValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffRevisionsReplyValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffRevisionsReplyValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffRevisionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffRevisionsReplyMultiError is an error wrapping multiple validation errors
+// returned by DiffRevisionsReply.ValidateAll() if the designated constraints
+// aren't met.
+type DiffRevisionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffRevisionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffRevisionsReplyMultiError) AllErrors() []error { return m }
+
+// DiffRevisionsReplyValidationError is the validation error returned by
+// DiffRevisionsReply.Validate if the designated constraints aren't met.
+type DiffRevisionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffRevisionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffRevisionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffRevisionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffRevisionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffRevisionsReplyValidationError) ErrorName() string {
+	return "DiffRevisionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffRevisionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffRevisionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffRevisionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffRevisionsReplyValidationError{}
+
+// Validate checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionRequestMultiError, or nil if none found.
+func (m *RestoreRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := RestoreRevisionRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRev() < 1 {
+		err := RestoreRevisionRequestValidationError{
+			field:  "Rev",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRevisionRequestValidationError is the validation error returned by
+// RestoreRevisionRequest.Validate if the designated constraints aren't met.
+type RestoreRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRevisionRequestValidationError) ErrorName() string {
+	return "RestoreRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRevisionRequestValidationError{}
+
+// Validate checks the field values on RestoreRevisionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreRevisionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRevisionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreRevisionReplyMultiError, or nil if none found.
+func (m *RestoreRevisionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRevisionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreRevisionReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreRevisionReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreRevisionReplyValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreRevisionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreRevisionReplyMultiError is an error wrapping multiple validation
+// errors returned by RestoreRevisionReply.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreRevisionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRevisionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRevisionReplyMultiError) AllErrors() []error { return m }
+
+// RestoreRevisionReplyValidationError is the validation error returned by
+// RestoreRevisionReply.Validate if the designated constraints aren't met.
+type RestoreRevisionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRevisionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRevisionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRevisionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRevisionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRevisionReplyValidationError) ErrorName() string {
+	return "RestoreRevisionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreRevisionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRevisionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRevisionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRevisionReplyValidationError{}
+
+// Validate checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueRequestMultiError, or nil if none found.
+func (m *ListModerationQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListModerationQueueRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListModerationQueueRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.BoardId != nil {
+
+		if m.GetBoardId() < 1 {
+			err := ListModerationQueueRequestValidationError{
+				field:  "BoardId",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueRequestMultiError is an error wrapping multiple
+// validation errors returned by ListModerationQueueRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueRequestMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueRequestValidationError is the validation error returned
+// by ListModerationQueueRequest.Validate if the designated constraints aren't met.
+type ListModerationQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueRequestValidationError) ErrorName() string {
+	return "ListModerationQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueRequestValidationError{}
+
+// Validate checks the field values on ListModerationQueueReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueReplyMultiError, or nil if none found.
+func (m *ListModerationQueueReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModerationQueueReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModerationQueueReplyValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModerationQueueReplyValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueReplyMultiError is an error wrapping multiple validation
+// errors returned by ListModerationQueueReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListModerationQueueReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueReplyMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueReplyValidationError is the validation error returned by
+// ListModerationQueueReply.Validate if the designated constraints aren't met.
+type ListModerationQueueReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueReplyValidationError) ErrorName() string {
+	return "ListModerationQueueReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueReplyValidationError{}
+
+// Validate checks the field values on ApprovePostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApprovePostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApprovePostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApprovePostRequestMultiError, or nil if none found.
+func (m *ApprovePostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApprovePostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := ApprovePostRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApprovePostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApprovePostRequestMultiError is an error wrapping multiple validation errors
+// returned by ApprovePostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApprovePostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApprovePostRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApprovePostRequestMultiError) AllErrors() []error { return m }
+
+// ApprovePostRequestValidationError is the validation error returned by
+// ApprovePostRequest.Validate if the designated constraints aren't met.
+type ApprovePostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApprovePostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApprovePostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApprovePostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApprovePostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApprovePostRequestValidationError) ErrorName() string {
+	return "ApprovePostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApprovePostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApprovePostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApprovePostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApprovePostRequestValidationError{}
+
+// Validate checks the field values on ApprovePostReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApprovePostReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApprovePostReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApprovePostReplyMultiError, or nil if none found.
+func (m *ApprovePostReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApprovePostReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApprovePostReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApprovePostReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApprovePostReplyValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApprovePostReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApprovePostReplyMultiError is an error wrapping multiple validation errors
+// returned by ApprovePostReply.ValidateAll() if the designated constraints
+// aren't met.
+type ApprovePostReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApprovePostReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApprovePostReplyMultiError) AllErrors() []error { return m }
+
+// ApprovePostReplyValidationError is the validation error returned by
+// ApprovePostReply.Validate if the designated constraints aren't met.
+type ApprovePostReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApprovePostReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApprovePostReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApprovePostReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApprovePostReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApprovePostReplyValidationError) ErrorName() string { return "ApprovePostReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApprovePostReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApprovePostReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApprovePostReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApprovePostReplyValidationError{}
+
+// Validate checks the field values on RejectPostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RejectPostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectPostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectPostRequestMultiError, or nil if none found.
+func (m *RejectPostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectPostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := RejectPostRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 200 {
+		err := RejectPostRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RejectPostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectPostRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectPostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectPostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectPostRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectPostRequestMultiError) AllErrors() []error { return m }
+
+// RejectPostRequestValidationError is the validation error returned by
+// RejectPostRequest.Validate if the designated constraints aren't met.
+type RejectPostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectPostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectPostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectPostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectPostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectPostRequestValidationError) ErrorName() string {
+	return "RejectPostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectPostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectPostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectPostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectPostRequestValidationError{}
+
+// Validate checks the field values on RejectPostReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RejectPostReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectPostReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectPostReplyMultiError, or nil if none found.
+func (m *RejectPostReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectPostReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectPostReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectPostReplyValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectPostReplyValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectPostReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectPostReplyMultiError is an error wrapping multiple validation errors
+// returned by RejectPostReply.ValidateAll() if the designated constraints
+// aren't met.
+type RejectPostReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectPostReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectPostReplyMultiError) AllErrors() []error { return m }
+
+// RejectPostReplyValidationError is the validation error returned by
+// RejectPostReply.Validate if the designated constraints aren't met.
+type RejectPostReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectPostReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectPostReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectPostReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectPostReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectPostReplyValidationError) ErrorName() string { return "RejectPostReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RejectPostReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectPostReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectPostReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectPostReplyValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListNotificationsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListNotificationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsReplyMultiError, or nil if none found.
+func (m *ListNotificationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsReplyValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsReplyValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsReplyValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsReplyMultiError) AllErrors() []error { return m }
+
+// ListNotificationsReplyValidationError is the validation error returned by
+// ListNotificationsReply.Validate if the designated constraints aren't met.
+type ListNotificationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsReplyValidationError) ErrorName() string {
+	return "ListNotificationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsReplyValidationError{}
+
+// Validate checks the field values on MarkNotificationsReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkNotificationsReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkNotificationsReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkNotificationsReadRequestMultiError, or nil if none found.
+func (m *MarkNotificationsReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkNotificationsReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMaxId() < 1 {
+		err := MarkNotificationsReadRequestValidationError{
+			field:  "MaxId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkNotificationsReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkNotificationsReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkNotificationsReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkNotificationsReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkNotificationsReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkNotificationsReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkNotificationsReadRequestValidationError is the validation error returned
+// by MarkNotificationsReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkNotificationsReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNotificationsReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNotificationsReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNotificationsReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNotificationsReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNotificationsReadRequestValidationError) ErrorName() string {
+	return "MarkNotificationsReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNotificationsReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNotificationsReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNotificationsReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNotificationsReadRequestValidationError{}
+
+// Validate checks the field values on MarkNotificationsReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkNotificationsReadReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkNotificationsReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkNotificationsReadReplyMultiError, or nil if none found.
+func (m *MarkNotificationsReadReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkNotificationsReadReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Marked
+
+	if len(errors) > 0 {
+		return MarkNotificationsReadReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkNotificationsReadReplyMultiError is an error wrapping multiple
+// validation errors returned by MarkNotificationsReadReply.ValidateAll() if
+// the designated constraints aren't met.
+type MarkNotificationsReadReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkNotificationsReadReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkNotificationsReadReplyMultiError) AllErrors() []error { return m }
+
+// MarkNotificationsReadReplyValidationError is the validation error returned
+// by MarkNotificationsReadReply.Validate if the designated constraints aren't met.
+type MarkNotificationsReadReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNotificationsReadReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNotificationsReadReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNotificationsReadReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNotificationsReadReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNotificationsReadReplyValidationError) ErrorName() string {
+	return "MarkNotificationsReadReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNotificationsReadReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNotificationsReadReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNotificationsReadReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNotificationsReadReplyValidationError{}
+
+// Validate checks the field values on ListFilterLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFilterLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilterLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFilterLogsRequestMultiError, or nil if none found.
+func (m *ListFilterLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilterLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListFilterLogsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListFilterLogsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.TargetType != nil {
+
+		if _, ok := _ListFilterLogsRequest_TargetType_InLookup[m.GetTargetType()]; !ok {
+			err := ListFilterLogsRequestValidationError{
+				field:  "TargetType",
+				reason: "value must be in list [post comment]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TargetId != nil {
+
+		if m.GetTargetId() < 1 {
+			err := ListFilterLogsRequestValidationError{
+				field:  "TargetId",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFilterLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilterLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFilterLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFilterLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilterLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilterLogsRequestMultiError) AllErrors() []error { return m }
+
+// ListFilterLogsRequestValidationError is the validation error returned by
+// ListFilterLogsRequest.Validate if the designated constraints aren't met.
+type ListFilterLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilterLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilterLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilterLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilterLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilterLogsRequestValidationError) ErrorName() string {
+	return "ListFilterLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFilterLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilterLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilterLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilterLogsRequestValidationError{}
+
+var _ListFilterLogsRequest_TargetType_InLookup = map[string]struct{}{
+	"post":    {},
+	"comment": {},
+}
+
+// Validate checks the field values on ListFilterLogsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFilterLogsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFilterLogsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFilterLogsReplyMultiError, or nil if none found.
+func (m *ListFilterLogsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFilterLogsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFilterLogsReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFilterLogsReplyValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFilterLogsReplyValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFilterLogsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFilterLogsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListFilterLogsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListFilterLogsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFilterLogsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFilterLogsReplyMultiError) AllErrors() []error { return m }
+
+// ListFilterLogsReplyValidationError is the validation error returned by
+// ListFilterLogsReply.Validate if the designated constraints aren't met.
+type ListFilterLogsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFilterLogsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFilterLogsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFilterLogsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFilterLogsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFilterLogsReplyValidationError) ErrorName() string {
+	return "ListFilterLogsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFilterLogsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFilterLogsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFilterLogsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFilterLogsReplyValidationError{}
+
+// Validate checks the field values on PinPostRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PinPostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinPostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PinPostRequestMultiError,
+// or nil if none found.
+func (m *PinPostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinPostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := PinPostRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PinPostRequest_Scope_InLookup[m.GetScope()]; !ok {
+		err := PinPostRequestValidationError{
+			field:  "Scope",
+			reason: "value must be in list [global tag board]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ScopeKey
+
+	if m.ExpireTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpireTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PinPostRequestValidationError{
+						field:  "ExpireTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PinPostRequestValidationError{
+						field:  "ExpireTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PinPostRequestValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PinPostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinPostRequestMultiError is an error wrapping multiple validation errors
+// returned by PinPostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PinPostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinPostRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinPostRequestMultiError) AllErrors() []error { return m }
+
+// PinPostRequestValidationError is the validation error returned by
+// PinPostRequest.Validate if the designated constraints aren't met.
+type PinPostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinPostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinPostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinPostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinPostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinPostRequestValidationError) ErrorName() string { return "PinPostRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PinPostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinPostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinPostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinPostRequestValidationError{}
+
+var _PinPostRequest_Scope_InLookup = map[string]struct{}{
+	"global": {},
+	"tag":    {},
+	"board":  {},
+}
+
+// Validate checks the field values on PinPostReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PinPostReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinPostReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PinPostReplyMultiError, or
+// nil if none found.
+func (m *PinPostReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinPostReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return PinPostReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinPostReplyMultiError is an error wrapping multiple validation errors
+// returned by PinPostReply.ValidateAll() if the designated constraints aren't met.
+type PinPostReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinPostReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinPostReplyMultiError) AllErrors() []error { return m }
+
+// PinPostReplyValidationError is the validation error returned by
+// PinPostReply.Validate if the designated constraints aren't met.
+type PinPostReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinPostReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinPostReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinPostReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinPostReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinPostReplyValidationError) ErrorName() string { return "PinPostReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PinPostReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinPostReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinPostReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinPostReplyValidationError{}
+
+// Validate checks the field values on UnpinPostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnpinPostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinPostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinPostRequestMultiError, or nil if none found.
+func (m *UnpinPostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinPostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPid() < 1 {
+		err := UnpinPostRequestValidationError{
+			field:  "Pid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UnpinPostRequest_Scope_InLookup[m.GetScope()]; !ok {
+		err := UnpinPostRequestValidationError{
+			field:  "Scope",
+			reason: "value must be in list [global tag board]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ScopeKey
+
+	if len(errors) > 0 {
+		return UnpinPostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinPostRequestMultiError is an error wrapping multiple validation errors
+// returned by UnpinPostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnpinPostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinPostRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinPostRequestMultiError) AllErrors() []error { return m }
+
+// UnpinPostRequestValidationError is the validation error returned by
+// UnpinPostRequest.Validate if the designated constraints aren't met.
+type UnpinPostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinPostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinPostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinPostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinPostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinPostRequestValidationError) ErrorName() string { return "UnpinPostRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnpinPostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinPostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinPostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinPostRequestValidationError{}
+
+var _UnpinPostRequest_Scope_InLookup = map[string]struct{}{
+	"global": {},
+	"tag":    {},
+	"board":  {},
+}
+
+// Validate checks the field values on UnpinPostReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnpinPostReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinPostReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnpinPostReplyMultiError,
+// or nil if none found.
+func (m *UnpinPostReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinPostReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return UnpinPostReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinPostReplyMultiError is an error wrapping multiple validation errors
+// returned by UnpinPostReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnpinPostReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinPostReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinPostReplyMultiError) AllErrors() []error { return m }
+
+// UnpinPostReplyValidationError is the validation error returned by
+// UnpinPostReply.Validate if the designated constraints aren't met.
+type UnpinPostReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinPostReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinPostReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinPostReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinPostReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinPostReplyValidationError) ErrorName() string { return "UnpinPostReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnpinPostReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinPostReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinPostReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinPostReplyValidationError{}
+
+// Validate checks the field values on CreateBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBoardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBoardRequestMultiError, or nil if none found.
+func (m *CreateBoardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBoardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateBoardRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateBoardRequest_Slug_Pattern.MatchString(m.GetSlug()) {
+		err := CreateBoardRequestValidationError{
+			field:  "Slug",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9][a-zA-Z0-9-]{0,31}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := CreateBoardRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateBoardRequest_PostPermission_InLookup[m.GetPostPermission()]; !ok {
+		err := CreateBoardRequestValidationError{
+			field:  "PostPermission",
+			reason: "value must be in list [0 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateBoardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBoardRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateBoardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBoardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBoardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBoardRequestMultiError) AllErrors() []error { return m }
+
+// CreateBoardRequestValidationError is the validation error returned by
+// CreateBoardRequest.Validate if the designated constraints aren't met.
+type CreateBoardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBoardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBoardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBoardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBoardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBoardRequestValidationError) ErrorName() string {
+	return "CreateBoardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBoardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBoardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBoardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBoardRequestValidationError{}
+
+var _CreateBoardRequest_Slug_Pattern = regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9-]{0,31}$")
+
+var _CreateBoardRequest_PostPermission_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+}
+
+// Validate checks the field values on CreateBoardReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBoardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBoardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBoardReplyMultiError, or nil if none found.
+func (m *CreateBoardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBoardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetBoard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBoardReplyValidationError{
+				field:  "Board",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBoardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBoardReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateBoardReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBoardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBoardReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBoardReplyMultiError) AllErrors() []error { return m }
+
+// CreateBoardReplyValidationError is the validation error returned by
+// CreateBoardReply.Validate if the designated constraints aren't met.
+type CreateBoardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBoardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBoardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBoardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBoardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBoardReplyValidationError) ErrorName() string { return "CreateBoardReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateBoardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBoardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBoardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBoardReplyValidationError{}
+
+// Validate checks the field values on UpdateBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBoardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBoardRequestMultiError, or nil if none found.
+func (m *UpdateBoardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBoardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateBoardRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Name != nil {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+			err := UpdateBoardRequestValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 500 {
+			err := UpdateBoardRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 500 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PostPermission != nil {
+
+		if _, ok := _UpdateBoardRequest_PostPermission_InLookup[m.GetPostPermission()]; !ok {
+			err := UpdateBoardRequestValidationError{
+				field:  "PostPermission",
+				reason: "value must be in list [0 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateBoardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBoardRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBoardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBoardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBoardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBoardRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBoardRequestValidationError is the validation error returned by
+// UpdateBoardRequest.Validate if the designated constraints aren't met.
+type UpdateBoardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBoardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBoardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBoardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBoardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBoardRequestValidationError) ErrorName() string {
+	return "UpdateBoardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBoardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBoardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBoardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBoardRequestValidationError{}
+
+var _UpdateBoardRequest_PostPermission_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+}
+
+// Validate checks the field values on UpdateBoardReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBoardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBoardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBoardReplyMultiError, or nil if none found.
+func (m *UpdateBoardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBoardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetBoard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBoardReplyValidationError{
+				field:  "Board",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBoardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBoardReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateBoardReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBoardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBoardReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBoardReplyMultiError) AllErrors() []error { return m }
+
+// UpdateBoardReplyValidationError is the validation error returned by
+// UpdateBoardReply.Validate if the designated constraints aren't met.
+type UpdateBoardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBoardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBoardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBoardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBoardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBoardReplyValidationError) ErrorName() string { return "UpdateBoardReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateBoardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBoardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBoardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBoardReplyValidationError{}
+
+// Validate checks the field values on GetBoardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBoardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBoardRequestMultiError, or nil if none found.
+func (m *GetBoardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 0 {
+		err := GetBoardRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Slug
+
+	if len(errors) > 0 {
+		return GetBoardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoardRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBoardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBoardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoardRequestMultiError) AllErrors() []error { return m }
+
+// GetBoardRequestValidationError is the validation error returned by
+// GetBoardRequest.Validate if the designated constraints aren't met.
+type GetBoardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoardRequestValidationError) ErrorName() string { return "GetBoardRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBoardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoardRequestValidationError{}
+
+// Validate checks the field values on GetBoardReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBoardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoardReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBoardReplyMultiError, or
+// nil if none found.
+func (m *GetBoardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetBoard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBoardReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBoardReplyValidationError{
+				field:  "Board",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBoardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoardReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBoardReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBoardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoardReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoardReplyMultiError) AllErrors() []error { return m }
+
+// GetBoardReplyValidationError is the validation error returned by
+// GetBoardReply.Validate if the designated constraints aren't met.
+type GetBoardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoardReplyValidationError) ErrorName() string { return "GetBoardReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBoardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoardReplyValidationError{}
+
+// Validate checks the field values on ListBoardsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBoardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoardsRequestMultiError, or nil if none found.
+func (m *ListBoardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListBoardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoardsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBoardsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBoardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoardsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoardsRequestMultiError) AllErrors() []error { return m }
+
+// ListBoardsRequestValidationError is the validation error returned by
+// ListBoardsRequest.Validate if the designated constraints aren't met.
+type ListBoardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoardsRequestValidationError) ErrorName() string {
+	return "ListBoardsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBoardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoardsRequestValidationError{}
+
+// Validate checks the field values on ListBoardsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBoardsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoardsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoardsReplyMultiError, or nil if none found.
+func (m *ListBoardsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoardsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetBoards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBoardsReplyValidationError{
+						field:  fmt.Sprintf("Boards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBoardsReplyValidationError{
+						field:  fmt.Sprintf("Boards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBoardsReplyValidationError{
+					field:  fmt.Sprintf("Boards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBoardsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoardsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListBoardsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListBoardsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoardsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoardsReplyMultiError) AllErrors() []error { return m }
+
+// ListBoardsReplyValidationError is the validation error returned by
+// ListBoardsReply.Validate if the designated constraints aren't met.
+type ListBoardsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoardsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoardsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoardsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoardsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoardsReplyValidationError) ErrorName() string { return "ListBoardsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBoardsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoardsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoardsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoardsReplyValidationError{}
+
+// Validate checks the field values on AddBoardModeratorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBoardModeratorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBoardModeratorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBoardModeratorRequestMultiError, or nil if none found.
+func (m *AddBoardModeratorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBoardModeratorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBoardId() < 1 {
+		err := AddBoardModeratorRequestValidationError{
+			field:  "BoardId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUid() < 1 {
+		err := AddBoardModeratorRequestValidationError{
+			field:  "Uid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddBoardModeratorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBoardModeratorRequestMultiError is an error wrapping multiple validation
+// errors returned by AddBoardModeratorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddBoardModeratorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBoardModeratorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBoardModeratorRequestMultiError) AllErrors() []error { return m }
+
+// AddBoardModeratorRequestValidationError is the validation error returned by
+// AddBoardModeratorRequest.Validate if the designated constraints aren't met.
+type AddBoardModeratorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBoardModeratorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBoardModeratorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBoardModeratorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBoardModeratorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBoardModeratorRequestValidationError) ErrorName() string {
+	return "AddBoardModeratorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBoardModeratorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBoardModeratorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBoardModeratorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBoardModeratorRequestValidationError{}
+
+// Validate checks the field values on AddBoardModeratorReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBoardModeratorReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBoardModeratorReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBoardModeratorReplyMultiError, or nil if none found.
+func (m *AddBoardModeratorReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBoardModeratorReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetBoard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddBoardModeratorReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddBoardModeratorReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddBoardModeratorReplyValidationError{
+				field:  "Board",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddBoardModeratorReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBoardModeratorReplyMultiError is an error wrapping multiple validation
+// errors returned by AddBoardModeratorReply.ValidateAll() if the designated
+// constraints aren't met.
+type AddBoardModeratorReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBoardModeratorReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBoardModeratorReplyMultiError) AllErrors() []error { return m }
+
+// AddBoardModeratorReplyValidationError is the validation error returned by
+// AddBoardModeratorReply.Validate if the designated constraints aren't met.
+type AddBoardModeratorReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBoardModeratorReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBoardModeratorReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBoardModeratorReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBoardModeratorReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBoardModeratorReplyValidationError) ErrorName() string {
+	return "AddBoardModeratorReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBoardModeratorReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBoardModeratorReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBoardModeratorReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBoardModeratorReplyValidationError{}
+
+// Validate checks the field values on RemoveBoardModeratorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBoardModeratorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBoardModeratorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBoardModeratorRequestMultiError, or nil if none found.
+func (m *RemoveBoardModeratorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBoardModeratorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBoardId() < 1 {
+		err := RemoveBoardModeratorRequestValidationError{
+			field:  "BoardId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUid() < 1 {
+		err := RemoveBoardModeratorRequestValidationError{
+			field:  "Uid",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveBoardModeratorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveBoardModeratorRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveBoardModeratorRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveBoardModeratorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBoardModeratorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBoardModeratorRequestMultiError) AllErrors() []error { return m }
+
+// RemoveBoardModeratorRequestValidationError is the validation error returned
+// by RemoveBoardModeratorRequest.Validate if the designated constraints
+// aren't met.
+type RemoveBoardModeratorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBoardModeratorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBoardModeratorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBoardModeratorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBoardModeratorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBoardModeratorRequestValidationError) ErrorName() string {
+	return "RemoveBoardModeratorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBoardModeratorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBoardModeratorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBoardModeratorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBoardModeratorRequestValidationError{}
+
+// Validate checks the field values on RemoveBoardModeratorReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveBoardModeratorReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveBoardModeratorReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBoardModeratorReplyMultiError, or nil if none found.
+func (m *RemoveBoardModeratorReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveBoardModeratorReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetBoard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveBoardModeratorReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveBoardModeratorReplyValidationError{
+					field:  "Board",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveBoardModeratorReplyValidationError{
+				field:  "Board",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveBoardModeratorReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveBoardModeratorReplyMultiError is an error wrapping multiple validation
+// errors returned by RemoveBoardModeratorReply.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveBoardModeratorReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveBoardModeratorReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveBoardModeratorReplyMultiError) AllErrors() []error { return m }
+
+// RemoveBoardModeratorReplyValidationError is the validation error returned by
+// RemoveBoardModeratorReply.Validate if the designated constraints aren't met.
+type RemoveBoardModeratorReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveBoardModeratorReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveBoardModeratorReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveBoardModeratorReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveBoardModeratorReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveBoardModeratorReplyValidationError) ErrorName() string {
+	return "RemoveBoardModeratorReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveBoardModeratorReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveBoardModeratorReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveBoardModeratorReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveBoardModeratorReplyValidationError{}
+
+// Validate checks the field values on ReportContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportContentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportContentRequestMultiError, or nil if none found.
+func (m *ReportContentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportContentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ReportContentRequest_TargetType_InLookup[m.GetTargetType()]; !ok {
+		err := ReportContentRequestValidationError{
+			field:  "TargetType",
+			reason: "value must be in list [post comment]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetId() < 1 {
+		err := ReportContentRequestValidationError{
+			field:  "TargetId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 16 {
+		err := ReportContentRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDetail()) > 500 {
+		err := ReportContentRequestValidationError{
+			field:  "Detail",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportContentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportContentRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportContentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportContentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportContentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportContentRequestMultiError) AllErrors() []error { return m }
+
+// ReportContentRequestValidationError is the validation error returned by
+// ReportContentRequest.Validate if the designated constraints aren't met.
+type ReportContentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportContentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportContentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportContentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportContentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportContentRequestValidationError) ErrorName() string {
+	return "ReportContentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportContentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportContentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportContentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportContentRequestValidationError{}
+
+var _ReportContentRequest_TargetType_InLookup = map[string]struct{}{
+	"post":    {},
+	"comment": {},
+}
+
+// Validate checks the field values on ReportContentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportContentReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportContentReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportContentReplyMultiError, or nil if none found.
+func (m *ReportContentReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportContentReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return ReportContentReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportContentReplyMultiError is an error wrapping multiple validation errors
+// returned by ReportContentReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReportContentReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportContentReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportContentReplyMultiError) AllErrors() []error { return m }
+
+// ReportContentReplyValidationError is the validation error returned by
+// ReportContentReply.Validate if the designated constraints aren't met.
+type ReportContentReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportContentReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportContentReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportContentReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportContentReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportContentReplyValidationError) ErrorName() string {
+	return "ReportContentReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportContentReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportContentReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportContentReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportContentReplyValidationError{}
+
+// Validate checks the field values on ListReportCasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportCasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportCasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportCasesRequestMultiError, or nil if none found.
+func (m *ListReportCasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportCasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListReportCasesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListReportCasesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListReportCasesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListReportCasesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportCasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportCasesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReportCasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportCasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportCasesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportCasesRequestMultiError) AllErrors() []error { return m }
+
+// ListReportCasesRequestValidationError is the validation error returned by
+// ListReportCasesRequest.Validate if the designated constraints aren't met.
+type ListReportCasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportCasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportCasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportCasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportCasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportCasesRequestValidationError) ErrorName() string {
+	return "ListReportCasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportCasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportCasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportCasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportCasesRequestValidationError{}
+
+var _ListReportCasesRequest_Status_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on ListReportCasesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportCasesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportCasesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportCasesReplyMultiError, or nil if none found.
+func (m *ListReportCasesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportCasesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetCases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportCasesReplyValidationError{
+						field:  fmt.Sprintf("Cases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportCasesReplyValidationError{
+						field:  fmt.Sprintf("Cases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportCasesReplyValidationError{
+					field:  fmt.Sprintf("Cases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportCasesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportCasesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReportCasesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportCasesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportCasesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportCasesReplyMultiError) AllErrors() []error { return m }
+
+// ListReportCasesReplyValidationError is the validation error returned by
+// ListReportCasesReply.Validate if the designated constraints aren't met.
+type ListReportCasesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportCasesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportCasesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportCasesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportCasesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportCasesReplyValidationError) ErrorName() string {
+	return "ListReportCasesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportCasesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportCasesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportCasesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportCasesReplyValidationError{}
+
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCaseId() < 1 {
+		err := ListReportsRequestValidationError{
+			field:  "CaseId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListReportsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListReportsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsRequestValidationError{}
+
+// Validate checks the field values on ListReportsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsReplyMultiError, or nil if none found.
+func (m *ListReportsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsReplyValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsReplyValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportsReplyValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListReportsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsReplyMultiError) AllErrors() []error { return m }
+
+// ListReportsReplyValidationError is the validation error returned by
+// ListReportsReply.Validate if the designated constraints aren't met.
+type ListReportsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsReplyValidationError) ErrorName() string { return "ListReportsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListReportsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsReplyValidationError{}
+
+// Validate checks the field values on ResolveReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveReportRequestMultiError, or nil if none found.
+func (m *ResolveReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCaseId() < 1 {
+		err := ResolveReportRequestValidationError{
+			field:  "CaseId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetOutcome()); l < 1 || l > 200 {
+		err := ResolveReportRequestValidationError{
+			field:  "Outcome",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResolveReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveReportRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveReportRequestMultiError) AllErrors() []error { return m }
+
+// ResolveReportRequestValidationError is the validation error returned by
+// ResolveReportRequest.Validate if the designated constraints aren't met.
+type ResolveReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveReportRequestValidationError) ErrorName() string {
+	return "ResolveReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveReportRequestValidationError{}
+
+// Validate checks the field values on ResolveReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveReportReplyMultiError, or nil if none found.
+func (m *ResolveReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveReportReplyValidationError{
+					field:  "Case",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveReportReplyValidationError{
+					field:  "Case",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveReportReplyValidationError{
+				field:  "Case",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResolveReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveReportReplyMultiError is an error wrapping multiple validation errors
+// returned by ResolveReportReply.ValidateAll() if the designated constraints
+// aren't met.
+type ResolveReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveReportReplyMultiError) AllErrors() []error { return m }
+
+// ResolveReportReplyValidationError is the validation error returned by
+// ResolveReportReply.Validate if the designated constraints aren't met.
+type ResolveReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveReportReplyValidationError) ErrorName() string {
+	return "ResolveReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveReportReplyValidationError{}
+
+// Validate checks the field values on DismissReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DismissReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DismissReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DismissReportRequestMultiError, or nil if none found.
+func (m *DismissReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DismissReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCaseId() < 1 {
+		err := DismissReportRequestValidationError{
+			field:  "CaseId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOutcome()) > 200 {
+		err := DismissReportRequestValidationError{
+			field:  "Outcome",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DismissReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DismissReportRequestMultiError is an error wrapping multiple validation
+// errors returned by DismissReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DismissReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DismissReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DismissReportRequestMultiError) AllErrors() []error { return m }
+
+// DismissReportRequestValidationError is the validation error returned by
+// DismissReportRequest.Validate if the designated constraints aren't met.
+type DismissReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DismissReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DismissReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DismissReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DismissReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DismissReportRequestValidationError) ErrorName() string {
+	return "DismissReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DismissReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDismissReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DismissReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DismissReportRequestValidationError{}
+
+// Validate checks the field values on DismissReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DismissReportReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DismissReportReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DismissReportReplyMultiError, or nil if none found.
+func (m *DismissReportReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DismissReportReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetCase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DismissReportReplyValidationError{
+					field:  "Case",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DismissReportReplyValidationError{
+					field:  "Case",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DismissReportReplyValidationError{
+				field:  "Case",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DismissReportReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DismissReportReplyMultiError is an error wrapping multiple validation errors
+// returned by DismissReportReply.ValidateAll() if the designated constraints
+// aren't met.
+type DismissReportReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DismissReportReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DismissReportReplyMultiError) AllErrors() []error { return m }
+
+// DismissReportReplyValidationError is the validation error returned by
+// DismissReportReply.Validate if the designated constraints aren't met.
+type DismissReportReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DismissReportReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DismissReportReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DismissReportReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DismissReportReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DismissReportReplyValidationError) ErrorName() string {
+	return "DismissReportReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DismissReportReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDismissReportReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DismissReportReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DismissReportReplyValidationError{}
+
+// Validate checks the field values on ReportCase with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportCase) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportCase with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportCaseMultiError, or
+// nil if none found.
+func (m *ReportCase) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportCase) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for OwnerUid
+
+	// no validation rules for Excerpt
+
+	// no validation rules for Weight
+
+	// no validation rules for ReportCount
+
+	// no validation rules for Hidden
+
+	// no validation rules for Status
+
+	// no validation rules for HandlerUid
+
+	// no validation rules for Outcome
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportCaseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportCaseValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportCaseValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.HandleTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetHandleTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportCaseValidationError{
+						field:  "HandleTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportCaseValidationError{
+						field:  "HandleTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHandleTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportCaseValidationError{
+					field:  "HandleTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportCaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportCaseMultiError is an error wrapping multiple validation errors
+// returned by ReportCase.ValidateAll() if the designated constraints aren't met.
+type ReportCaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportCaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportCaseMultiError) AllErrors() []error { return m }
+
+// ReportCaseValidationError is the validation error returned by
+// ReportCase.Validate if the designated constraints aren't met.
+type ReportCaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportCaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportCaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportCaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportCaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportCaseValidationError) ErrorName() string { return "ReportCaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportCaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportCase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportCaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportCaseValidationError{}
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CaseId
+
+	// no validation rules for ReporterUid
+
+	// no validation rules for Reason
+
+	// no validation rules for Detail
+
+	// no validation rules for Weight
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on FilterLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FilterLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FilterLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FilterLogMultiError, or nil
+// if none found.
+func (m *FilterLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FilterLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for Uid
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FilterLogValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FilterLogValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FilterLogValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FilterLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// FilterLogMultiError is an error wrapping multiple validation errors returned
+// by FilterLog.ValidateAll() if the designated constraints aren't met.
+type FilterLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FilterLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FilterLogMultiError) AllErrors() []error { return m }
+
+// FilterLogValidationError is the validation error returned by
+// FilterLog.Validate if the designated constraints aren't met.
+type FilterLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FilterLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FilterLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FilterLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FilterLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FilterLogValidationError) ErrorName() string { return "FilterLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FilterLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFilterLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FilterLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FilterLogValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Pid
+
+	// no validation rules for Content
+
+	// no validation rules for IsRead
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on Revision with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Revision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Revision with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevisionMultiError, or nil
+// if none found.
+func (m *Revision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Revision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pid
+
+	// no validation rules for Rev
+
+	// no validation rules for EditorUid
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevisionValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevisionValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevisionMultiError is an error wrapping multiple validation errors returned
+// by Revision.ValidateAll() if the designated constraints aren't met.
+type RevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevisionMultiError) AllErrors() []error { return m }
+
+// RevisionValidationError is the validation error returned by
+// Revision.Validate if the designated constraints aren't met.
+type RevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevisionValidationError) ErrorName() string { return "RevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevisionValidationError{}
+
+// Validate checks the field values on DiffLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffLineMultiError, or nil
+// if none found.
+func (m *DiffLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Op
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return DiffLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffLineMultiError is an error wrapping multiple validation errors returned
+// by DiffLine.ValidateAll() if the designated constraints aren't met.
+type DiffLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffLineMultiError) AllErrors() []error { return m }
+
+// DiffLineValidationError is the validation error returned by
+// DiffLine.Validate if the designated constraints aren't met.
+type DiffLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffLineValidationError) ErrorName() string { return "DiffLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffLineValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TitleHighlight
+
+	// no validation rules for ContentHighlight
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on Board with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Board) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Board with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BoardMultiError, or nil if none found.
+func (m *Board) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Board) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Slug
+
+	// no validation rules for Description
+
+	// no validation rules for PostPermission
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BoardValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BoardValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BoardValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BoardValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BoardValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BoardValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BoardMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoardMultiError is an error wrapping multiple validation errors returned by
+// Board.ValidateAll() if the designated constraints aren't met.
+type BoardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoardMultiError) AllErrors() []error { return m }
+
+// BoardValidationError is the validation error returned by Board.Validate if
+// the designated constraints aren't met.
+type BoardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoardValidationError) ErrorName() string { return "BoardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBoard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoardValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for PostCount
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on Post with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Post) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Post with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PostMultiError, or nil if none found.
+func (m *Post) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Post) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Pid
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, PostValidationError{
@@ -1908,6 +12394,14 @@ func (m *Post) validate(all bool) error {
 
 	// no validation rules for LikeCount
 
+	// no validation rules for CommentCount
+
+	// no validation rules for FavoriteCount
+
+	// no validation rules for Version
+
+	// no validation rules for BoardId
+
 	if m.IsDel != nil {
 		// no validation rules for IsDel
 	}
@@ -2087,6 +12581,14 @@ func (m *PostPreview) validate(all bool) error {
 
 	// no validation rules for LikeCount
 
+	// no validation rules for CommentCount
+
+	// no validation rules for FavoriteCount
+
+	// no validation rules for Pinned
+
+	// no validation rules for BoardId
+
 	if len(errors) > 0 {
 		return PostPreviewMultiError(errors)
 	}
@@ -28,7 +28,67 @@ type PostSrvClient interface {
 	GetPostPreview(ctx context.Context, in *GetPostPreviewRequest, opts ...grpc.CallOption) (*GetPostPreviewReply, error)
 	GetPostDetail(ctx context.Context, in *GetPostDetailRequest, opts ...grpc.CallOption) (*GetPostDetailReply, error)
 	ListPostPreview(ctx context.Context, in *ListPostPreviewRequest, opts ...grpc.CallOption) (*ListPostPreviewReply, error)
+	// 批量获取帖子简要信息，按请求中pids的顺序返回，不存在的帖子会被忽略
+	BatchGetPostPreviews(ctx context.Context, in *BatchGetPostPreviewsRequest, opts ...grpc.CallOption) (*BatchGetPostPreviewsReply, error)
 	AddPostLike(ctx context.Context, in *AddPostLikeRequest, opts ...grpc.CallOption) (*AddPostLikeReply, error)
+	// 按点赞时间倒序列出当前用户点赞过的帖子
+	ListMyLikedPosts(ctx context.Context, in *ListMyLikedPostsRequest, opts ...grpc.CallOption) (*ListMyLikedPostsReply, error)
+	// 按加权互动数排序的日榜、周榜与总榜，定时更新
+	ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardReply, error)
+	ListPostsByTag(ctx context.Context, in *ListPostsByTagRequest, opts ...grpc.CallOption) (*ListPostsByTagReply, error)
+	// 按帖子数倒序列出标签
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error)
+	// 最近几天内发帖与点赞最多的标签
+	ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsReply, error)
+	// 在标题、正文、作者与标签中全文搜索
+	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsReply, error)
+	// 新建或覆盖保存草稿，设置publish_at后到时间自动发布
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftReply, error)
+	// 按最后修改时间倒序列出当前用户的草稿
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsReply, error)
+	DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftReply, error)
+	// 立即发布草稿，发布后草稿被删除
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftReply, error)
+	// 按版本号倒序列出帖子的编辑历史，不包含正文
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsReply, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionReply, error)
+	// 逐行比较两个版本的正文
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsReply, error)
+	// 版主将帖子恢复为某个历史版本
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionReply, error)
+	// 版主按发帖时间正序查看待审核的帖子
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueReply, error)
+	ApprovePost(ctx context.Context, in *ApprovePostRequest, opts ...grpc.CallOption) (*ApprovePostReply, error)
+	// 驳回后帖子对其他人隐藏，驳回原因会通知作者
+	RejectPost(ctx context.Context, in *RejectPostRequest, opts ...grpc.CallOption) (*RejectPostReply, error)
+	// 按时间倒序列出当前用户的通知
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsReply, error)
+	// 将id不大于max_id的通知标记为已读
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadReply, error)
+	// 版主按时间倒序查看敏感词命中记录
+	ListFilterLogs(ctx context.Context, in *ListFilterLogsRequest, opts ...grpc.CallOption) (*ListFilterLogsReply, error)
+	// 举报帖子或评论，同一用户对同一内容只能举报一次
+	ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentReply, error)
+	// 版主查看举报单，待处理的按举报权重倒序
+	ListReportCases(ctx context.Context, in *ListReportCasesRequest, opts ...grpc.CallOption) (*ListReportCasesReply, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsReply, error)
+	// 举报成立，内容被隐藏
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportReply, error)
+	// 驳回举报，被自动隐藏的内容恢复可见
+	DismissReport(ctx context.Context, in *DismissReportRequest, opts ...grpc.CallOption) (*DismissReportReply, error)
+	// 版主在全局、标签或版块下置顶帖子，置顶的帖子显示在对应列表第一页的开头
+	// 全局与标签置顶只有全站版主可以操作，版块置顶由版块的版主操作
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostReply, error)
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostReply, error)
+	// 全站版主创建与修改版块，slug创建后不能修改
+	CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*CreateBoardReply, error)
+	UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardReply, error)
+	// 按id或slug查询版块
+	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardReply, error)
+	ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsReply, error)
+	// 全站版主管理版块的版主，版块的版主只能审核、置顶与恢复本版块的帖子
+	AddBoardModerator(ctx context.Context, in *AddBoardModeratorRequest, opts ...grpc.CallOption) (*AddBoardModeratorReply, error)
+	RemoveBoardModerator(ctx context.Context, in *RemoveBoardModeratorRequest, opts ...grpc.CallOption) (*RemoveBoardModeratorReply, error)
 }
 
 type postSrvClient struct {
@@ -93,6 +153,15 @@ func (c *postSrvClient) ListPostPreview(ctx context.Context, in *ListPostPreview
 	return out, nil
 }
 
+func (c *postSrvClient) BatchGetPostPreviews(ctx context.Context, in *BatchGetPostPreviewsRequest, opts ...grpc.CallOption) (*BatchGetPostPreviewsReply, error) {
+	out := new(BatchGetPostPreviewsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/BatchGetPostPreviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postSrvClient) AddPostLike(ctx context.Context, in *AddPostLikeRequest, opts ...grpc.CallOption) (*AddPostLikeReply, error) {
 	out := new(AddPostLikeReply)
 	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/AddPostLike", in, out, opts...)
@@ -102,6 +171,303 @@ func (c *postSrvClient) AddPostLike(ctx context.Context, in *AddPostLikeRequest,
 	return out, nil
 }
 
+func (c *postSrvClient) ListMyLikedPosts(ctx context.Context, in *ListMyLikedPostsRequest, opts ...grpc.CallOption) (*ListMyLikedPostsReply, error) {
+	out := new(ListMyLikedPostsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListMyLikedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardReply, error) {
+	out := new(ListLeaderboardReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListPostsByTag(ctx context.Context, in *ListPostsByTagRequest, opts ...grpc.CallOption) (*ListPostsByTagReply, error) {
+	out := new(ListPostsByTagReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListPostsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error) {
+	out := new(ListTagsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListTrendingTags(ctx context.Context, in *ListTrendingTagsRequest, opts ...grpc.CallOption) (*ListTrendingTagsReply, error) {
+	out := new(ListTrendingTagsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListTrendingTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsReply, error) {
+	out := new(SearchPostsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/SearchPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*SaveDraftReply, error) {
+	out := new(SaveDraftReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/SaveDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsReply, error) {
+	out := new(ListDraftsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListDrafts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) DeleteDraft(ctx context.Context, in *DeleteDraftRequest, opts ...grpc.CallOption) (*DeleteDraftReply, error) {
+	out := new(DeleteDraftReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/DeleteDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*PublishDraftReply, error) {
+	out := new(PublishDraftReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/PublishDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsReply, error) {
+	out := new(ListRevisionsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionReply, error) {
+	out := new(GetRevisionReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsReply, error) {
+	out := new(DiffRevisionsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/DiffRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*RestoreRevisionReply, error) {
+	out := new(RestoreRevisionReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueReply, error) {
+	out := new(ListModerationQueueReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListModerationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ApprovePost(ctx context.Context, in *ApprovePostRequest, opts ...grpc.CallOption) (*ApprovePostReply, error) {
+	out := new(ApprovePostReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ApprovePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) RejectPost(ctx context.Context, in *RejectPostRequest, opts ...grpc.CallOption) (*RejectPostReply, error) {
+	out := new(RejectPostReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/RejectPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsReply, error) {
+	out := new(ListNotificationsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadReply, error) {
+	out := new(MarkNotificationsReadReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/MarkNotificationsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListFilterLogs(ctx context.Context, in *ListFilterLogsRequest, opts ...grpc.CallOption) (*ListFilterLogsReply, error) {
+	out := new(ListFilterLogsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListFilterLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*ReportContentReply, error) {
+	out := new(ReportContentReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ReportContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListReportCases(ctx context.Context, in *ListReportCasesRequest, opts ...grpc.CallOption) (*ListReportCasesReply, error) {
+	out := new(ListReportCasesReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListReportCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsReply, error) {
+	out := new(ListReportsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportReply, error) {
+	out := new(ResolveReportReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ResolveReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) DismissReport(ctx context.Context, in *DismissReportRequest, opts ...grpc.CallOption) (*DismissReportReply, error) {
+	out := new(DismissReportReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/DismissReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostReply, error) {
+	out := new(PinPostReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/PinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostReply, error) {
+	out := new(UnpinPostReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/UnpinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*CreateBoardReply, error) {
+	out := new(CreateBoardReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/CreateBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardReply, error) {
+	out := new(UpdateBoardReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/UpdateBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*GetBoardReply, error) {
+	out := new(GetBoardReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/GetBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsReply, error) {
+	out := new(ListBoardsReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/ListBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) AddBoardModerator(ctx context.Context, in *AddBoardModeratorRequest, opts ...grpc.CallOption) (*AddBoardModeratorReply, error) {
+	out := new(AddBoardModeratorReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/AddBoardModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postSrvClient) RemoveBoardModerator(ctx context.Context, in *RemoveBoardModeratorRequest, opts ...grpc.CallOption) (*RemoveBoardModeratorReply, error) {
+	out := new(RemoveBoardModeratorReply)
+	err := c.cc.Invoke(ctx, "/api.post.v1.PostSrv/RemoveBoardModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostSrvServer is the server API for PostSrv service.
 // All implementations must embed UnimplementedPostSrvServer
 // for forward compatibility
@@ -112,7 +478,67 @@ type PostSrvServer interface {
 	GetPostPreview(context.Context, *GetPostPreviewRequest) (*GetPostPreviewReply, error)
 	GetPostDetail(context.Context, *GetPostDetailRequest) (*GetPostDetailReply, error)
 	ListPostPreview(context.Context, *ListPostPreviewRequest) (*ListPostPreviewReply, error)
+	// 批量获取帖子简要信息，按请求中pids的顺序返回，不存在的帖子会被忽略
+	BatchGetPostPreviews(context.Context, *BatchGetPostPreviewsRequest) (*BatchGetPostPreviewsReply, error)
 	AddPostLike(context.Context, *AddPostLikeRequest) (*AddPostLikeReply, error)
+	// 按点赞时间倒序列出当前用户点赞过的帖子
+	ListMyLikedPosts(context.Context, *ListMyLikedPostsRequest) (*ListMyLikedPostsReply, error)
+	// 按加权互动数排序的日榜、周榜与总榜，定时更新
+	ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardReply, error)
+	ListPostsByTag(context.Context, *ListPostsByTagRequest) (*ListPostsByTagReply, error)
+	// 按帖子数倒序列出标签
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsReply, error)
+	// 最近几天内发帖与点赞最多的标签
+	ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsReply, error)
+	// 在标题、正文、作者与标签中全文搜索
+	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsReply, error)
+	// 新建或覆盖保存草稿，设置publish_at后到时间自动发布
+	SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftReply, error)
+	// 按最后修改时间倒序列出当前用户的草稿
+	ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsReply, error)
+	DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftReply, error)
+	// 立即发布草稿，发布后草稿被删除
+	PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftReply, error)
+	// 按版本号倒序列出帖子的编辑历史，不包含正文
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsReply, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionReply, error)
+	// 逐行比较两个版本的正文
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsReply, error)
+	// 版主将帖子恢复为某个历史版本
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionReply, error)
+	// 版主按发帖时间正序查看待审核的帖子
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error)
+	ApprovePost(context.Context, *ApprovePostRequest) (*ApprovePostReply, error)
+	// 驳回后帖子对其他人隐藏，驳回原因会通知作者
+	RejectPost(context.Context, *RejectPostRequest) (*RejectPostReply, error)
+	// 按时间倒序列出当前用户的通知
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsReply, error)
+	// 将id不大于max_id的通知标记为已读
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadReply, error)
+	// 版主按时间倒序查看敏感词命中记录
+	ListFilterLogs(context.Context, *ListFilterLogsRequest) (*ListFilterLogsReply, error)
+	// 举报帖子或评论，同一用户对同一内容只能举报一次
+	ReportContent(context.Context, *ReportContentRequest) (*ReportContentReply, error)
+	// 版主查看举报单，待处理的按举报权重倒序
+	ListReportCases(context.Context, *ListReportCasesRequest) (*ListReportCasesReply, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsReply, error)
+	// 举报成立，内容被隐藏
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportReply, error)
+	// 驳回举报，被自动隐藏的内容恢复可见
+	DismissReport(context.Context, *DismissReportRequest) (*DismissReportReply, error)
+	// 版主在全局、标签或版块下置顶帖子，置顶的帖子显示在对应列表第一页的开头
+	// 全局与标签置顶只有全站版主可以操作，版块置顶由版块的版主操作
+	PinPost(context.Context, *PinPostRequest) (*PinPostReply, error)
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostReply, error)
+	// 全站版主创建与修改版块，slug创建后不能修改
+	CreateBoard(context.Context, *CreateBoardRequest) (*CreateBoardReply, error)
+	UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardReply, error)
+	// 按id或slug查询版块
+	GetBoard(context.Context, *GetBoardRequest) (*GetBoardReply, error)
+	ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsReply, error)
+	// 全站版主管理版块的版主，版块的版主只能审核、置顶与恢复本版块的帖子
+	AddBoardModerator(context.Context, *AddBoardModeratorRequest) (*AddBoardModeratorReply, error)
+	RemoveBoardModerator(context.Context, *RemoveBoardModeratorRequest) (*RemoveBoardModeratorReply, error)
 	mustEmbedUnimplementedPostSrvServer()
 }
 
@@ -138,9 +564,111 @@ func (UnimplementedPostSrvServer) GetPostDetail(context.Context, *GetPostDetailR
 func (UnimplementedPostSrvServer) ListPostPreview(context.Context, *ListPostPreviewRequest) (*ListPostPreviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPostPreview not implemented")
 }
+func (UnimplementedPostSrvServer) BatchGetPostPreviews(context.Context, *BatchGetPostPreviewsRequest) (*BatchGetPostPreviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPostPreviews not implemented")
+}
 func (UnimplementedPostSrvServer) AddPostLike(context.Context, *AddPostLikeRequest) (*AddPostLikeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPostLike not implemented")
 }
+func (UnimplementedPostSrvServer) ListMyLikedPosts(context.Context, *ListMyLikedPostsRequest) (*ListMyLikedPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyLikedPosts not implemented")
+}
+func (UnimplementedPostSrvServer) ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboard not implemented")
+}
+func (UnimplementedPostSrvServer) ListPostsByTag(context.Context, *ListPostsByTagRequest) (*ListPostsByTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByTag not implemented")
+}
+func (UnimplementedPostSrvServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedPostSrvServer) ListTrendingTags(context.Context, *ListTrendingTagsRequest) (*ListTrendingTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingTags not implemented")
+}
+func (UnimplementedPostSrvServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedPostSrvServer) SaveDraft(context.Context, *SaveDraftRequest) (*SaveDraftReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedPostSrvServer) ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedPostSrvServer) DeleteDraft(context.Context, *DeleteDraftRequest) (*DeleteDraftReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedPostSrvServer) PublishDraft(context.Context, *PublishDraftRequest) (*PublishDraftReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedPostSrvServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedPostSrvServer) GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedPostSrvServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedPostSrvServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*RestoreRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedPostSrvServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedPostSrvServer) ApprovePost(context.Context, *ApprovePostRequest) (*ApprovePostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePost not implemented")
+}
+func (UnimplementedPostSrvServer) RejectPost(context.Context, *RejectPostRequest) (*RejectPostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPost not implemented")
+}
+func (UnimplementedPostSrvServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedPostSrvServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedPostSrvServer) ListFilterLogs(context.Context, *ListFilterLogsRequest) (*ListFilterLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilterLogs not implemented")
+}
+func (UnimplementedPostSrvServer) ReportContent(context.Context, *ReportContentRequest) (*ReportContentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedPostSrvServer) ListReportCases(context.Context, *ListReportCasesRequest) (*ListReportCasesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportCases not implemented")
+}
+func (UnimplementedPostSrvServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedPostSrvServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedPostSrvServer) DismissReport(context.Context, *DismissReportRequest) (*DismissReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissReport not implemented")
+}
+func (UnimplementedPostSrvServer) PinPost(context.Context, *PinPostRequest) (*PinPostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedPostSrvServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedPostSrvServer) CreateBoard(context.Context, *CreateBoardRequest) (*CreateBoardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoard not implemented")
+}
+func (UnimplementedPostSrvServer) UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoard not implemented")
+}
+func (UnimplementedPostSrvServer) GetBoard(context.Context, *GetBoardRequest) (*GetBoardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
+func (UnimplementedPostSrvServer) ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoards not implemented")
+}
+func (UnimplementedPostSrvServer) AddBoardModerator(context.Context, *AddBoardModeratorRequest) (*AddBoardModeratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBoardModerator not implemented")
+}
+func (UnimplementedPostSrvServer) RemoveBoardModerator(context.Context, *RemoveBoardModeratorRequest) (*RemoveBoardModeratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBoardModerator not implemented")
+}
 func (UnimplementedPostSrvServer) mustEmbedUnimplementedPostSrvServer() {}
 
 // UnsafePostSrvServer may be embedded to opt out of forward compatibility for this service.
@@ -262,6 +790,24 @@ func _PostSrv_ListPostPreview_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostSrv_BatchGetPostPreviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPostPreviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).BatchGetPostPreviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/BatchGetPostPreviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).BatchGetPostPreviews(ctx, req.(*BatchGetPostPreviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostSrv_AddPostLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPostLikeRequest)
 	if err := dec(in); err != nil {
@@ -280,11 +826,605 @@ func _PostSrv_AddPostLike_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// PostSrv_ServiceDesc is the grpc.ServiceDesc for PostSrv service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PostSrv_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.post.v1.PostSrv",
+func _PostSrv_ListMyLikedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyLikedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListMyLikedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListMyLikedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListMyLikedPosts(ctx, req.(*ListMyLikedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListLeaderboard(ctx, req.(*ListLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListPostsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListPostsByTag(ctx, req.(*ListPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListTrendingTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListTrendingTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListTrendingTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListTrendingTags(ctx, req.(*ListTrendingTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).SearchPosts(ctx, req.(*SearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/SaveDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListDrafts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/DeleteDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).DeleteDraft(ctx, req.(*DeleteDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).PublishDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/PublishDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).PublishDraft(ctx, req.(*PublishDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/DiffRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/RestoreRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListModerationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ApprovePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ApprovePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ApprovePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ApprovePost(ctx, req.(*ApprovePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_RejectPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).RejectPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/RejectPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).RejectPost(ctx, req.(*RejectPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/MarkNotificationsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListFilterLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilterLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListFilterLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListFilterLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListFilterLogs(ctx, req.(*ListFilterLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ReportContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ReportContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ReportContent(ctx, req.(*ReportContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListReportCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportCasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListReportCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListReportCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListReportCases(ctx, req.(*ListReportCasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ResolveReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_DismissReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).DismissReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/DismissReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).DismissReport(ctx, req.(*DismissReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).PinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/PinPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).PinPost(ctx, req.(*PinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).UnpinPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/UnpinPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).UnpinPost(ctx, req.(*UnpinPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_CreateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).CreateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/CreateBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).CreateBoard(ctx, req.(*CreateBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_UpdateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).UpdateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/UpdateBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).UpdateBoard(ctx, req.(*UpdateBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).GetBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/GetBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).GetBoard(ctx, req.(*GetBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_ListBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).ListBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/ListBoards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).ListBoards(ctx, req.(*ListBoardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_AddBoardModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBoardModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).AddBoardModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/AddBoardModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).AddBoardModerator(ctx, req.(*AddBoardModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostSrv_RemoveBoardModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBoardModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostSrvServer).RemoveBoardModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.post.v1.PostSrv/RemoveBoardModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostSrvServer).RemoveBoardModerator(ctx, req.(*RemoveBoardModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PostSrv_ServiceDesc is the grpc.ServiceDesc for PostSrv service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PostSrv_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.post.v1.PostSrv",
 	HandlerType: (*PostSrvServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -311,10 +1451,146 @@ var PostSrv_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPostPreview",
 			Handler:    _PostSrv_ListPostPreview_Handler,
 		},
+		{
+			MethodName: "BatchGetPostPreviews",
+			Handler:    _PostSrv_BatchGetPostPreviews_Handler,
+		},
 		{
 			MethodName: "AddPostLike",
 			Handler:    _PostSrv_AddPostLike_Handler,
 		},
+		{
+			MethodName: "ListMyLikedPosts",
+			Handler:    _PostSrv_ListMyLikedPosts_Handler,
+		},
+		{
+			MethodName: "ListLeaderboard",
+			Handler:    _PostSrv_ListLeaderboard_Handler,
+		},
+		{
+			MethodName: "ListPostsByTag",
+			Handler:    _PostSrv_ListPostsByTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _PostSrv_ListTags_Handler,
+		},
+		{
+			MethodName: "ListTrendingTags",
+			Handler:    _PostSrv_ListTrendingTags_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _PostSrv_SearchPosts_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _PostSrv_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _PostSrv_ListDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _PostSrv_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _PostSrv_PublishDraft_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _PostSrv_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _PostSrv_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _PostSrv_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _PostSrv_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _PostSrv_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ApprovePost",
+			Handler:    _PostSrv_ApprovePost_Handler,
+		},
+		{
+			MethodName: "RejectPost",
+			Handler:    _PostSrv_RejectPost_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _PostSrv_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _PostSrv_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "ListFilterLogs",
+			Handler:    _PostSrv_ListFilterLogs_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _PostSrv_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReportCases",
+			Handler:    _PostSrv_ListReportCases_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _PostSrv_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _PostSrv_ResolveReport_Handler,
+		},
+		{
+			MethodName: "DismissReport",
+			Handler:    _PostSrv_DismissReport_Handler,
+		},
+		{
+			MethodName: "PinPost",
+			Handler:    _PostSrv_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _PostSrv_UnpinPost_Handler,
+		},
+		{
+			MethodName: "CreateBoard",
+			Handler:    _PostSrv_CreateBoard_Handler,
+		},
+		{
+			MethodName: "UpdateBoard",
+			Handler:    _PostSrv_UpdateBoard_Handler,
+		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _PostSrv_GetBoard_Handler,
+		},
+		{
+			MethodName: "ListBoards",
+			Handler:    _PostSrv_ListBoards_Handler,
+		},
+		{
+			MethodName: "AddBoardModerator",
+			Handler:    _PostSrv_AddBoardModerator_Handler,
+		},
+		{
+			MethodName: "RemoveBoardModerator",
+			Handler:    _PostSrv_RemoveBoardModerator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/post/v1/post.proto",
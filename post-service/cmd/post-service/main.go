@@ -40,6 +40,14 @@ func newApp(logger log.Logger, gs *grpc.Server, job *job.JobRepo) *kratos.App {
 	}
 	{
 		go job.PostInfoJob(context.Background())
+		go job.RankJob(context.Background())
+		go job.ViewFlushJob(context.Background())
+		go job.LikeEventJob(context.Background())
+		go job.LikeReconcileJob(context.Background())
+		go job.DraftPublishJob(context.Background())
+		go job.FilterReloadJob(context.Background())
+		go job.PinExpireJob(context.Background())
+		go job.SearchBackfillJob(context.Background())
 	}
 
 	// client, err := api.NewClient(api.DefaultConfig())
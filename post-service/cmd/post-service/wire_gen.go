@@ -30,11 +30,21 @@ func wireApp(confServer *conf.Server, confBiz *conf.Biz, confData *conf.Data, lo
 		return nil, nil, err
 	}
 	postRepo := data.NewPostRepo(dataData, logger)
-	postUsecase := biz.NewPostUsecase(confBiz, postRepo, logger)
-	postSrvService := service.NewPostSrvService(postUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, postSrvService, logger)
+	node := biz.NewSfNode(confBiz)
+	hotRanker := biz.NewHotRanker(confBiz)
+	contentFilter := biz.NewContentFilter(confBiz, logger)
+	client := data.NewLocker(dataData)
+	postUsecase := biz.NewPostUsecase(confBiz, postRepo, node, hotRanker, contentFilter, client, logger)
+	postSrvService := service.NewPostSrvService(confServer, postUsecase, logger)
+	commentRepo := data.NewCommentRepo(dataData, logger)
+	commentUsecase := biz.NewCommentUsecase(commentRepo, postRepo, postUsecase, node, contentFilter, logger)
+	commentSrvService := service.NewCommentSrvService(commentUsecase, logger)
+	favoriteRepo := data.NewFavoriteRepo(dataData, logger)
+	favoriteUsecase := biz.NewFavoriteUsecase(favoriteRepo, postRepo, postUsecase, node, logger)
+	favoriteSrvService := service.NewFavoriteSrvService(favoriteUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, postSrvService, commentSrvService, favoriteSrvService, postUsecase, logger)
 	dataPostRepo := data.NewPostRepoForJob(dataData, logger)
-	jobRepo := job.NewJobRepo(confData, dataPostRepo, dataData, logger)
+	jobRepo := job.NewJobRepo(confData, dataPostRepo, dataData, hotRanker, postUsecase, contentFilter, client, logger)
 	app := newApp(logger, grpcServer, jobRepo)
 	return app, func() {
 		cleanup()
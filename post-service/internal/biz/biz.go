@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewPostUsecase)
+var ProviderSet = wire.NewSet(NewSfNode, NewHotRanker, NewContentFilter, NewPostUsecase, NewCommentUsecase, NewFavoriteUsecase)
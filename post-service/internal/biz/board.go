@@ -0,0 +1,240 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"post-service/internal/model"
+	"strconv"
+	"strings"
+)
+
+var (
+	errBoardNotExisted     = errors.New("board not existed")
+	errBoardSlugExisted    = errors.New("board slug already existed")
+	errBoardRequired       = errors.New("board is required")
+	errBoardPostDenied     = errors.New("permission denied: only board moderators can post in this board")
+	errBoardModNotExisted  = errors.New("user is not a moderator of the board")
+	errUserNotExisted      = errors.New("user not existed")
+	errPostNotInBoard      = errors.New("post is not in the board")
+	errBoardPostPermission = errors.New("unknown board post permission")
+)
+
+func validBoardPostPermission(p int32) bool {
+	return p == model.BoardPostAll || p == model.BoardPostModerators
+}
+
+// CreateBoard 全站版主创建版块，slug统一为小写
+func (uc *PostUsecase) CreateBoard(ctx context.Context, board *model.Board) (*model.Board, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	if !validBoardPostPermission(board.PostPermission) {
+		return nil, errBoardPostPermission
+	}
+	board.Name = strings.TrimSpace(board.Name)
+	board.Slug = strings.ToLower(strings.TrimSpace(board.Slug))
+	if board.Name == "" || board.Slug == "" {
+		return nil, errInvalideParam
+	}
+	uc.log.WithContext(ctx).Infof("Creating board: %s", board.Slug)
+	created, err := uc.repo.CreateBoard(ctx, board)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateBoard/CreateBoard failed",
+			"err", err,
+			"slug", board.Slug,
+		)
+		return nil, err
+	}
+	if created == nil {
+		return nil, errBoardSlugExisted
+	}
+	return created, nil
+}
+
+// UpdateBoard 全站版主修改版块的名称、简介与发帖权限
+func (uc *PostUsecase) UpdateBoard(ctx context.Context, param *model.UpdateBoardParam) (*model.Board, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	if param.PostPermission != nil && !validBoardPostPermission(*param.PostPermission) {
+		return nil, errBoardPostPermission
+	}
+	if param.Name != nil {
+		name := strings.TrimSpace(*param.Name)
+		if name == "" {
+			return nil, errInvalideParam
+		}
+		param.Name = &name
+	}
+	uc.log.WithContext(ctx).Infof("Updating board: %d", param.Id)
+	board, err := uc.repo.UpdateBoard(ctx, param)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "UpdateBoard/UpdateBoard failed",
+			"err", err,
+			"board_id", param.Id,
+		)
+		return nil, err
+	}
+	if board == nil {
+		return nil, errBoardNotExisted
+	}
+	return board, nil
+}
+
+// GetBoard 按id或slug查询版块，id为0时按slug查询
+func (uc *PostUsecase) GetBoard(ctx context.Context, id int64, slug string) (*model.Board, error) {
+	board, err := uc.repo.GetBoard(ctx, id, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "GetBoard/GetBoard failed",
+			"err", err,
+			"board_id", id,
+		)
+		return nil, err
+	}
+	if board == nil {
+		return nil, errBoardNotExisted
+	}
+	return board, nil
+}
+
+func (uc *PostUsecase) ListBoards(ctx context.Context) ([]*model.Board, error) {
+	boards, err := uc.repo.ListBoards(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListBoards/ListBoards failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return boards, nil
+}
+
+// AddBoardModerator 全站版主为版块添加版主，重复添加时不报错
+func (uc *PostUsecase) AddBoardModerator(ctx context.Context, boardId, uid int64) (*model.Board, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := uc.GetBoard(ctx, boardId, ""); err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetUserByUid(ctx, uid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errUserNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "AddBoardModerator/GetUserByUid failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Adding moderator: %d to board: %d", uid, boardId)
+	if err := uc.repo.AddBoardModerator(ctx, boardId, uid); err != nil {
+		uc.log.Errorw(
+			"[biz]", "AddBoardModerator/AddBoardModerator failed",
+			"err", err,
+			"board_id", boardId,
+		)
+		return nil, err
+	}
+	return uc.GetBoard(ctx, boardId, "")
+}
+
+func (uc *PostUsecase) RemoveBoardModerator(ctx context.Context, boardId, uid int64) (*model.Board, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Removing moderator: %d from board: %d", uid, boardId)
+	ok, err := uc.repo.RemoveBoardModerator(ctx, boardId, uid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "RemoveBoardModerator/RemoveBoardModerator failed",
+			"err", err,
+			"board_id", boardId,
+		)
+		return nil, err
+	}
+	if !ok {
+		return nil, errBoardModNotExisted
+	}
+	return uc.GetBoard(ctx, boardId, "")
+}
+
+// checkBoardModerator 当前用户既不是全站版主也不是该版块的版主时返回errNotModerator
+func (uc *PostUsecase) checkBoardModerator(ctx context.Context, boardId int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "checkBoardModerator/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	ok, err := uc.repo.IsBoardModerator(ctx, uid, boardId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "checkBoardModerator/IsBoardModerator failed",
+			"err", err,
+			"uid", uid,
+			"board_id", boardId,
+		)
+		return err
+	}
+	if !ok {
+		return errNotModerator
+	}
+	return nil
+}
+
+// checkBoardPostable 版块不存在或uid没有该版块的发帖权限时返回错误
+// 定时发布的草稿没有登录信息，因此需要传入uid
+func (uc *PostUsecase) checkBoardPostable(ctx context.Context, uid, boardId int64) error {
+	if boardId == 0 {
+		return errBoardRequired
+	}
+	board, err := uc.GetBoard(ctx, boardId, "")
+	if err != nil {
+		return err
+	}
+	if board.PostPermission == model.BoardPostAll {
+		return nil
+	}
+	ok, err := uc.repo.IsBoardModerator(ctx, uid, boardId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "checkBoardPostable/IsBoardModerator failed",
+			"err", err,
+			"uid", uid,
+			"board_id", boardId,
+		)
+		return err
+	}
+	if !ok {
+		return errBoardPostDenied
+	}
+	return nil
+}
+
+// isBoardUnpostable 草稿没有选择版块、版块不存在或作者没有发帖权限，重试也无法发布
+func isBoardUnpostable(err error) bool {
+	return errors.Is(err, errBoardRequired) || errors.Is(err, errBoardNotExisted) || errors.Is(err, errBoardPostDenied)
+}
+
+// boardPinKey 版块置顶的scopeKey为版块id
+func boardPinKey(boardId int64) string {
+	return strconv.FormatInt(boardId, 10)
+}
+
+// withListHead 在列表第一页前依次插入置顶帖子与当前用户自己待审核的帖子
+// boardId为0时使用全局置顶，否则使用版块置顶
+func (uc *PostUsecase) withListHead(ctx context.Context, boardId int64, posts []*model.PostPreview) []*model.PostPreview {
+	posts = uc.withOwnPending(ctx, boardId, posts)
+	if boardId == 0 {
+		return uc.withPinned(ctx, model.PinScopeGlobal, "", posts)
+	}
+	return uc.withPinned(ctx, model.PinScopeBoard, boardPinKey(boardId), posts)
+}
@@ -0,0 +1,330 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"post-service/internal/common"
+	"post-service/internal/model"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/sony/gobreaker"
+)
+
+type CommentRepo interface {
+	CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	DeleteComment(ctx context.Context, comment *model.Comment) error
+	GetCommentByCid(ctx context.Context, cid int64) (*model.Comment, error)
+	ListComments(ctx context.Context, pid, page, pageSize int64, order string) ([]*model.Comment, error)
+	ListReplies(ctx context.Context, rootId, page, pageSize int64) ([]*model.Comment, error)
+	ListCommentsByHot(ctx context.Context, pid, page, pageSize int64) ([]*model.Comment, error)
+	ListCommentsByHotFallback(ctx context.Context, pid, page, pageSize int64) ([]*model.Comment, error)
+	UpdateCommentLike(ctx context.Context, comment *model.Comment, delta int64) (*model.Comment, error)
+}
+
+type CommentUsecase struct {
+	repo     CommentRepo
+	postRepo PostRepo
+	postUc   *PostUsecase // 帖子的可见性检查
+	node     *snowflake.Node
+	filter   *ContentFilter
+	log      log.Helper
+}
+
+func NewCommentUsecase(repo CommentRepo, postRepo PostRepo, postUc *PostUsecase, node *snowflake.Node, filter *ContentFilter, logger log.Logger) *CommentUsecase {
+	return &CommentUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		postUc:   postUc,
+		node:     node,
+		filter:   filter,
+		log:      *log.NewHelper(logger),
+	}
+}
+
+const StrLike = "like"
+
+var (
+	errCommentNotExisted = errors.New("comment not existed")
+	errNoPermission      = errors.New("no permission")
+)
+
+// CreateComment 发表评论或回复
+// 回复只有两级：回复一级评论或回复中的评论时，都挂在同一个一级评论下，并记录被回复的用户
+func (uc *CommentUsecase) CreateComment(ctx context.Context, param *model.CreateCommentParam) (*model.Comment, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateComment/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	if err := uc.checkPostVisible(ctx, param.Pid); err != nil {
+		return nil, err
+	}
+	// 评论没有审核队列，需要审核的词与拒绝的词一样不允许发布
+	filtered := uc.filter.Check(param.Content)
+	if filtered.Action >= FilterModerate {
+		recordFilterHits(ctx, uc.postRepo, &uc.log, model.TargetComment, 0, uid, filtered)
+		return nil, errSensitiveContent
+	}
+	user, err := uc.postRepo.GetUserByUid(ctx, uid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateComment/GetUserByUid failed",
+			"err", err,
+		)
+		return nil, err
+	}
+
+	comment := &model.Comment{
+		Cid:     uc.node.Generate().Int64(),
+		Pid:     param.Pid,
+		Uid:     uid,
+		Author:  user.Username,
+		Content: filtered.Texts[0],
+	}
+	if param.ReplyTo != 0 {
+		parent, err := uc.getComment(ctx, param.ReplyTo)
+		if err != nil {
+			return nil, err
+		}
+		if parent.Pid != param.Pid {
+			return nil, errInvalideParam
+		}
+		// 不能回复被举报隐藏的评论
+		if parent.Status != model.StatusNormal {
+			return nil, errCommentNotExisted
+		}
+		comment.RootId = parent.RootId
+		if comment.RootId == 0 {
+			comment.RootId = parent.Cid
+		}
+		comment.ReplyToUid = parent.Uid
+	}
+
+	uc.log.WithContext(ctx).Infof("Creating comment on post: %d by userID: %d", param.Pid, uid)
+	comment, err = uc.repo.CreateComment(ctx, comment)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateComment/CreateComment failed",
+			"err", err,
+			"pid", param.Pid,
+		)
+		return nil, err
+	}
+	recordFilterHits(ctx, uc.postRepo, &uc.log, model.TargetComment, comment.Cid, uid, filtered)
+	return comment, nil
+}
+
+// DeleteComment 评论作者与帖子作者可以删除评论，删除一级评论会同时删除其下的回复
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, cid int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteComment/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	comment, err := uc.getComment(ctx, cid)
+	if err != nil {
+		return err
+	}
+	if comment.Uid != uid {
+		post, err := uc.postRepo.GetPostById(ctx, comment.Pid)
+		if err != nil {
+			uc.log.Errorw(
+				"[biz]", "DeleteComment/GetPostById failed",
+				"err", err,
+				"pid", comment.Pid,
+			)
+			return err
+		}
+		if post.Uid != uid {
+			return errNoPermission
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("Deleting comment with CID: %d", cid)
+	if err := uc.repo.DeleteComment(ctx, comment); err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteComment/DeleteComment failed",
+			"err", err,
+			"cid", cid,
+		)
+		return err
+	}
+	return nil
+}
+
+func (uc *CommentUsecase) ListComments(ctx context.Context, pid, page, pageSize int64, order *string) ([]*model.Comment, error) {
+	if order == nil {
+		// 默认按时间排序
+		order = new(string)
+		*order = StrTime
+	}
+	if *order != StrTime && *order != StrLike && *order != StrHot {
+		return nil, errInvalideParam
+	}
+	if err := uc.checkPostVisible(ctx, pid); err != nil {
+		return nil, err
+	}
+	if *order == StrHot {
+		return uc.listCommentsByHot(ctx, pid, page, pageSize)
+	}
+	comments, err := uc.repo.ListComments(ctx, pid, page, pageSize, *order)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListComments/ListComments failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	return comments, nil
+}
+
+// listCommentsByHot 优先读取redis中的热评排行，熔断时回退到pg按同样的公式排序
+func (uc *CommentUsecase) listCommentsByHot(ctx context.Context, pid, page, pageSize int64) ([]*model.Comment, error) {
+	comments, err := uc.repo.ListCommentsByHot(ctx, pid, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListComments/ListCommentsByHot failed",
+			"err", err,
+			"pid", pid,
+		)
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			fallComments, err := uc.repo.ListCommentsByHotFallback(ctx, pid, page, pageSize)
+			if err != nil {
+				uc.log.Errorw(
+					"[biz]", "ListComments/ListCommentsByHotFallback failed",
+					"err", err,
+					"pid", pid,
+				)
+				return nil, err
+			}
+			return fallComments, nil
+		}
+		return nil, err
+	}
+	return comments, nil
+}
+
+// ListReplies 一级评论被隐藏或所在帖子不可见时按评论不存在处理
+func (uc *CommentUsecase) ListReplies(ctx context.Context, rootId, page, pageSize int64) ([]*model.Comment, error) {
+	root, err := uc.getComment(ctx, rootId)
+	if err != nil {
+		return nil, err
+	}
+	if root.Status != model.StatusNormal {
+		return nil, errCommentNotExisted
+	}
+	if err := uc.checkPostVisible(ctx, root.Pid); err != nil {
+		return nil, err
+	}
+	replies, err := uc.repo.ListReplies(ctx, rootId, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListReplies/ListReplies failed",
+			"err", err,
+			"rootId", rootId,
+		)
+		return nil, err
+	}
+	return replies, nil
+}
+
+// LikeComment 点赞或取消点赞评论，与帖子点赞一样使用redis集合去重
+func (uc *CommentUsecase) LikeComment(ctx context.Context, cid int64, like int32) (*model.Comment, error) {
+	if like != 0 && like != 1 {
+		return nil, errInvalideParam
+	}
+	comment, err := uc.getComment(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "LikeComment/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+
+	// 以SADD/SREM是否改变集合为准，并发的重复点赞只有一次计数
+	key := fmt.Sprintf(common.RKeyCommentLike, cid)
+	var delta int64
+	if like == 1 {
+		changed, err := uc.postRepo.AddRsetMem(ctx, key, uid)
+		if err != nil {
+			uc.log.Errorw(
+				"[biz]", "LikeComment/AddRsetMem failed",
+				"err", err,
+				"cid", cid,
+			)
+			return nil, err
+		}
+		if !changed {
+			return comment, nil // 已经点过赞，直接返回
+		}
+		delta = 1
+	} else {
+		changed, err := uc.postRepo.DelRsetMem(ctx, key, uid)
+		if err != nil {
+			uc.log.Errorw(
+				"[biz]", "LikeComment/DelRsetMem failed",
+				"err", err,
+				"cid", cid,
+			)
+			return nil, err
+		}
+		if !changed {
+			return comment, nil // 未点过赞，直接返回
+		}
+		delta = -1
+	}
+
+	replyComment, err := uc.repo.UpdateCommentLike(ctx, comment, delta)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "LikeComment/UpdateCommentLike failed",
+			"err", err,
+			"cid", cid,
+		)
+		return nil, err
+	}
+	return replyComment, nil
+}
+
+// checkPostVisible 帖子不存在或当前用户不可见时返回errPostNotExisted
+func (uc *CommentUsecase) checkPostVisible(ctx context.Context, pid int64) error {
+	if _, err := uc.postUc.GetPostById(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return errPostNotExisted
+		}
+		return err
+	}
+	return nil
+}
+
+func (uc *CommentUsecase) getComment(ctx context.Context, cid int64) (*model.Comment, error) {
+	comment, err := uc.repo.GetCommentByCid(ctx, cid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errCommentNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "getComment/GetCommentByCid failed",
+			"err", err,
+			"cid", cid,
+		)
+		return nil, err
+	}
+	return comment, nil
+}
@@ -0,0 +1,38 @@
+package biz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"post-service/internal/model"
+)
+
+var errCursorInvalid = errors.New("cursor is invalid")
+
+func encodeCursor(cursor *model.PostCursor) string {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor 空字符串表示第一页，返回nil
+func decodeCursor(s, searchType string) (*model.PostCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errCursorInvalid
+	}
+	cursor := new(model.PostCursor)
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, errCursorInvalid
+	}
+	// 游标不能跨排序方式使用
+	if cursor.Type != searchType || cursor.Pid <= 0 {
+		return nil, errCursorInvalid
+	}
+	return cursor, nil
+}
@@ -0,0 +1,270 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"post-service/third_party/redislock"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	minTitleLen = 6  // 与CreatePostRequest的校验规则一致，按字符计算
+	maxTitleLen = 64 // post_info.title的长度
+)
+
+var (
+	errDraftNotExisted = errors.New("draft not existed")
+	errDraftIncomplete = errors.New("draft title or content is too short to publish")
+	errPublishAtPassed = errors.New("publish_at must be in the future")
+)
+
+// SaveDraft 新建或覆盖保存草稿，PublishAt为nil时作为普通草稿保存，否则到时间后自动发布
+// 定时发布的草稿在保存时就按发帖的规则校验，避免到时间后才发现无法发布
+func (uc *PostUsecase) SaveDraft(ctx context.Context, param *model.SaveDraftParam) (*model.Draft, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "SaveDraft/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	param.Uid = uid
+	param.Title = strings.TrimSpace(param.Title)
+	if utf8.RuneCountInString(param.Title) > maxTitleLen {
+		return nil, errInvalideParam
+	}
+	if param.PublishAt != nil {
+		if !param.PublishAt.After(time.Now()) {
+			return nil, errPublishAtPassed
+		}
+		if !draftPublishable(param.Title, param.Content) {
+			return nil, errDraftIncomplete
+		}
+		if err := uc.checkBoardPostable(ctx, uid, param.BoardId); err != nil {
+			return nil, err
+		}
+	}
+	tags, err := uc.normalizeTags(ctx, param.Tags)
+	if err != nil {
+		return nil, err
+	}
+	param.Tags = tags
+
+	draft, err := uc.repo.SaveDraft(ctx, param, uc.node.Generate().Int64())
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "SaveDraft/SaveDraft failed",
+			"err", err,
+			"did", param.Did,
+		)
+		return nil, err
+	}
+	if draft == nil {
+		return nil, errDraftNotExisted
+	}
+	return draft, nil
+}
+
+func (uc *PostUsecase) ListDrafts(ctx context.Context, page, pageSize int64) ([]*model.Draft, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListDrafts/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	drafts, err := uc.repo.ListDrafts(ctx, uid, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListDrafts/ListDrafts failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return drafts, nil
+}
+
+func (uc *PostUsecase) DeleteDraft(ctx context.Context, did int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteDraft/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	ok, err := uc.repo.DeleteDraft(ctx, uid, did)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteDraft/DeleteDraft failed",
+			"err", err,
+			"did", did,
+		)
+		return err
+	}
+	if !ok {
+		return errDraftNotExisted
+	}
+	return nil
+}
+
+// PublishDraft 立即发布草稿，定时发布的草稿也可以提前发布
+func (uc *PostUsecase) PublishDraft(ctx context.Context, did int64) (*model.Post, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "PublishDraft/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	draft, err := uc.repo.GetDraft(ctx, uid, did)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "PublishDraft/GetDraft failed",
+			"err", err,
+			"did", did,
+		)
+		return nil, err
+	}
+	if draft == nil {
+		return nil, errDraftNotExisted
+	}
+	return uc.publishDraft(ctx, draft)
+}
+
+// PublishDueDrafts 发布定时发布时间已到的草稿，返回发布成功的数量
+// 单个草稿发布失败只记录日志，下次执行时重试
+func (uc *PostUsecase) PublishDueDrafts(ctx context.Context, now time.Time, limit int64) (int, error) {
+	drafts, err := uc.repo.ListDueDrafts(ctx, now, limit)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "PublishDueDrafts/ListDueDrafts failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	published := 0
+	for _, draft := range drafts {
+		if ctx.Err() != nil {
+			return published, ctx.Err()
+		}
+		if _, err := uc.publishDraft(ctx, draft); err != nil {
+			// 租约已被其他实例接手，剩余的草稿由新持有者发布
+			if errors.Is(err, redislock.ErrFenceStale) {
+				return published, err
+			}
+			if isDraftUnpublishable(err) {
+				uc.unscheduleDraft(ctx, draft)
+				continue
+			}
+			uc.log.Errorw(
+				"[biz]", "PublishDueDrafts/publishDraft failed",
+				"err", err,
+				"did", draft.Did,
+			)
+			continue
+		}
+		published++
+	}
+	return published, nil
+}
+
+// publishDraft 按发帖的流程补全草稿并发布，草稿已被发布或删除时返回errDraftNotExisted
+func (uc *PostUsecase) publishDraft(ctx context.Context, draft *model.Draft) (*model.Post, error) {
+	if !draftPublishable(draft.Title, draft.Content) {
+		return nil, errDraftIncomplete
+	}
+	if err := uc.checkBoardPostable(ctx, draft.Uid, draft.BoardId); err != nil {
+		return nil, err
+	}
+	filtered := uc.filter.Check(draft.Title, draft.Content)
+	if filtered.Action == FilterReject {
+		recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, 0, draft.Uid, filtered)
+		return nil, errSensitiveContent
+	}
+	user, err := uc.repo.GetUserByUid(ctx, draft.Uid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "publishDraft/GetUserByUid failed",
+			"err", err,
+			"uid", draft.Uid,
+		)
+		return nil, err
+	}
+	// 保存草稿后别名可能有变化，发布时重新统一
+	tags, err := uc.normalizeTags(ctx, draft.Tags)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	param := &model.CreatePostParam{
+		Pid:     uc.node.Generate().Int64(),
+		Title:   filtered.Texts[0],
+		Content: filtered.Texts[1],
+		Author:  user.Username,
+		Uid:     draft.Uid,
+		Score:   uc.ranker.Score(0, 0, 0, now, now),
+		Tags:    tags,
+		Status:  uc.initialStatus(),
+		BoardId: draft.BoardId,
+	}
+	if filtered.Action == FilterModerate {
+		param.Status = model.StatusPending
+	}
+
+	uc.log.WithContext(ctx).Infof("Publishing draft: %d by userID: %d", draft.Did, draft.Uid)
+	post, err := uc.repo.PublishDraft(ctx, draft.Did, param)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errDraftNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "publishDraft/PublishDraft failed",
+			"err", err,
+			"did", draft.Did,
+		)
+		return nil, err
+	}
+	recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, post.Pid, draft.Uid, filtered)
+	uc.updateTagCounts(ctx, post.Tags, nil)
+	uc.incrTagTrend(ctx, post.Tags, tagScoreOnCreate)
+	return post, nil
+}
+
+func draftPublishable(title, content string) bool {
+	return utf8.RuneCountInString(title) >= minTitleLen && content != ""
+}
+
+// isDraftUnpublishable 草稿内容不完整、标签不合法、含有敏感词或版块无法发帖，重试也无法发布
+func isDraftUnpublishable(err error) bool {
+	return errors.Is(err, errDraftIncomplete) || errors.Is(err, errTooManyTags) || errors.Is(err, errTagTooLong) ||
+		errors.Is(err, errSensitiveContent) || isBoardUnpostable(err)
+}
+
+// unscheduleDraft 草稿无法发布时取消定时发布，保留为普通草稿由作者修改，避免每次执行都重试
+func (uc *PostUsecase) unscheduleDraft(ctx context.Context, draft *model.Draft) {
+	_, err := uc.repo.SaveDraft(ctx, &model.SaveDraftParam{
+		Did:     draft.Did,
+		Uid:     draft.Uid,
+		Title:   draft.Title,
+		Content: draft.Content,
+		Tags:    draft.Tags,
+		BoardId: draft.BoardId,
+	}, 0)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "unscheduleDraft/SaveDraft failed",
+			"err", err,
+			"did", draft.Did,
+		)
+	}
+}
@@ -0,0 +1,351 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"post-service/internal/model"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5"
+)
+
+type FavoriteRepo interface {
+	AddFavorite(ctx context.Context, fav *model.Favorite) (bool, error)
+	RemoveFavorite(ctx context.Context, uid, pid int64) (bool, error)
+	ListFavorites(ctx context.Context, uid int64, collectionId *int64, page, pageSize int64) ([]*model.Favorite, error)
+	MoveFavorite(ctx context.Context, uid, pid, collectionId int64) (bool, error)
+
+	CreateCollection(ctx context.Context, collection *model.Collection) (*model.Collection, error)
+	RenameCollection(ctx context.Context, uid, collectionId int64, name string) (bool, error)
+	DeleteCollection(ctx context.Context, uid, collectionId int64) (bool, error)
+	ListCollections(ctx context.Context, uid int64) ([]*model.Collection, error)
+}
+
+type FavoriteUsecase struct {
+	repo     FavoriteRepo
+	postRepo PostRepo
+	postUc   *PostUsecase // 帖子的可见性检查
+	node     *snowflake.Node
+	log      log.Helper
+}
+
+func NewFavoriteUsecase(repo FavoriteRepo, postRepo PostRepo, postUc *PostUsecase, node *snowflake.Node, logger log.Logger) *FavoriteUsecase {
+	return &FavoriteUsecase{
+		repo:     repo,
+		postRepo: postRepo,
+		postUc:   postUc,
+		node:     node,
+		log:      *log.NewHelper(logger),
+	}
+}
+
+const (
+	maxCollections       = 50
+	maxCollectionNameLen = 32 // 按字符计算
+)
+
+var (
+	errFavoriteNotExisted   = errors.New("favorite not existed")
+	errCollectionNotExisted = errors.New("collection not existed")
+	errCollectionExisted    = errors.New("collection name already existed")
+	errTooManyCollections   = errors.New("too many collections")
+)
+
+// AddFavorite 收藏帖子，collectionId为0时放入未分类，重复收藏不做任何操作
+func (uc *FavoriteUsecase) AddFavorite(ctx context.Context, pid, collectionId int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "AddFavorite/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	// 只能收藏当前用户可见的帖子
+	if _, err := uc.postUc.GetPostById(ctx, pid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return errPostNotExisted
+		}
+		return err
+	}
+	if err := uc.checkCollection(ctx, uid, collectionId); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("Adding favorite post: %d by userID: %d", pid, uid)
+	if _, err := uc.repo.AddFavorite(ctx, &model.Favorite{
+		Uid:          uid,
+		Pid:          pid,
+		CollectionId: collectionId,
+	}); err != nil {
+		uc.log.Errorw(
+			"[biz]", "AddFavorite/AddFavorite failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	return nil
+}
+
+// RemoveFavorite 取消收藏，未收藏时不做任何操作
+func (uc *FavoriteUsecase) RemoveFavorite(ctx context.Context, pid int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "RemoveFavorite/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	if _, err := uc.repo.RemoveFavorite(ctx, uid, pid); err != nil {
+		uc.log.Errorw(
+			"[biz]", "RemoveFavorite/RemoveFavorite failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	return nil
+}
+
+// ListFavorites 按收藏时间倒序列出收藏，collectionId为nil时列出全部，为0时只列出未分类
+// 已删除或当前用户不可见的帖子保留收藏记录并标记为已移除
+func (uc *FavoriteUsecase) ListFavorites(ctx context.Context, collectionId *int64, page, pageSize int64) ([]*model.Favorite, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListFavorites/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	favs, err := uc.repo.ListFavorites(ctx, uid, collectionId, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListFavorites/ListFavorites failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	if len(favs) == 0 {
+		return favs, nil
+	}
+
+	pids := make([]int64, 0, len(favs))
+	for _, fav := range favs {
+		pids = append(pids, fav.Pid)
+	}
+	posts, err := uc.postRepo.BatchGetPostByIds(ctx, pids)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListFavorites/BatchGetPostByIds failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	for _, fav := range favs {
+		post, ok := posts[fav.Pid]
+		if !ok {
+			fav.Removed = true
+			continue
+		}
+		visible, err := uc.postUc.canView(ctx, post)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			fav.Removed = true
+			continue
+		}
+		fav.Post = post.ToPreview()
+	}
+	return favs, nil
+}
+
+// MoveFavorite 将收藏移动到另一个收藏夹，collectionId为0时移回未分类
+func (uc *FavoriteUsecase) MoveFavorite(ctx context.Context, pid, collectionId int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "MoveFavorite/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	if err := uc.checkCollection(ctx, uid, collectionId); err != nil {
+		return err
+	}
+	ok, err := uc.repo.MoveFavorite(ctx, uid, pid, collectionId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "MoveFavorite/MoveFavorite failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	if !ok {
+		return errFavoriteNotExisted
+	}
+	return nil
+}
+
+func (uc *FavoriteUsecase) CreateCollection(ctx context.Context, name string) (*model.Collection, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateCollection/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	name, err = normalizeCollectionName(name)
+	if err != nil {
+		return nil, err
+	}
+	collections, err := uc.listCollections(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) >= maxCollections {
+		return nil, errTooManyCollections
+	}
+	for _, c := range collections {
+		if c.Name == name {
+			return nil, errCollectionExisted
+		}
+	}
+
+	collection, err := uc.repo.CreateCollection(ctx, &model.Collection{
+		CollectionId: uc.node.Generate().Int64(),
+		Uid:          uid,
+		Name:         name,
+	})
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CreateCollection/CreateCollection failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return collection, nil
+}
+
+func (uc *FavoriteUsecase) RenameCollection(ctx context.Context, collectionId int64, name string) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "RenameCollection/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	name, err = normalizeCollectionName(name)
+	if err != nil {
+		return err
+	}
+	collections, err := uc.listCollections(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, c := range collections {
+		if c.Name == name && c.CollectionId != collectionId {
+			return errCollectionExisted
+		}
+	}
+
+	ok, err := uc.repo.RenameCollection(ctx, uid, collectionId, name)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "RenameCollection/RenameCollection failed",
+			"err", err,
+			"collectionId", collectionId,
+		)
+		return err
+	}
+	if !ok {
+		return errCollectionNotExisted
+	}
+	return nil
+}
+
+// DeleteCollection 删除收藏夹，其中的收藏移回未分类
+func (uc *FavoriteUsecase) DeleteCollection(ctx context.Context, collectionId int64) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteCollection/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	ok, err := uc.repo.DeleteCollection(ctx, uid, collectionId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "DeleteCollection/DeleteCollection failed",
+			"err", err,
+			"collectionId", collectionId,
+		)
+		return err
+	}
+	if !ok {
+		return errCollectionNotExisted
+	}
+	return nil
+}
+
+func (uc *FavoriteUsecase) ListCollections(ctx context.Context) ([]*model.Collection, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListCollections/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return uc.listCollections(ctx, uid)
+}
+
+func (uc *FavoriteUsecase) listCollections(ctx context.Context, uid int64) ([]*model.Collection, error) {
+	collections, err := uc.repo.ListCollections(ctx, uid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "listCollections/ListCollections failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return collections, nil
+}
+
+// checkCollection 检查收藏夹属于当前用户，0表示未分类
+func (uc *FavoriteUsecase) checkCollection(ctx context.Context, uid, collectionId int64) error {
+	if collectionId == 0 {
+		return nil
+	}
+	collections, err := uc.listCollections(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, c := range collections {
+		if c.CollectionId == collectionId {
+			return nil
+		}
+	}
+	return errCollectionNotExisted
+}
+
+func normalizeCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLen {
+		return "", errInvalideParam
+	}
+	return name, nil
+}
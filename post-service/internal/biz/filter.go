@@ -0,0 +1,303 @@
+package biz
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"post-service/internal/conf"
+	"post-service/internal/model"
+	"post-service/third_party/ahocorasick"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FilterAction 命中敏感词后的处理方式，值越大越严格，同时命中多个分类时取最严格的
+type FilterAction int
+
+const (
+	FilterPass FilterAction = iota
+	FilterMask
+	FilterModerate
+	FilterReject
+)
+
+var filterActionNames = map[FilterAction]string{
+	FilterPass:     "pass",
+	FilterMask:     "mask",
+	FilterModerate: "moderate",
+	FilterReject:   "reject",
+}
+
+func (a FilterAction) String() string {
+	return filterActionNames[a]
+}
+
+func parseFilterAction(s string) (FilterAction, error) {
+	for action, name := range filterActionNames {
+		if action != FilterPass && name == s {
+			return action, nil
+		}
+	}
+	return FilterPass, fmt.Errorf("unknown filter action: %q", s)
+}
+
+const dictFileExt = ".txt"
+
+var errSensitiveContent = errors.New("content contains sensitive words")
+
+// FilterResult Texts为按mask处理后的文本，顺序与传入Check的文本一致
+type FilterResult struct {
+	Action FilterAction
+	Texts  []string
+	Hits   []model.FilterHit
+}
+
+type dictWord struct {
+	term     string
+	category string
+	action   FilterAction
+}
+
+type sensitiveDict struct {
+	matcher *ahocorasick.Matcher
+	words   []dictWord
+	sig     string // 词库文件的名称、大小与修改时间，变化时才重新构建
+}
+
+// ContentFilter 敏感词过滤，词库在内存中整体替换，重新加载时不影响正在进行的检查
+type ContentFilter struct {
+	dir      string
+	actions  map[string]FilterAction
+	interval time.Duration
+
+	dict atomic.Pointer[sensitiveDict]
+	mu   sync.Mutex // 保证同一时间只有一个重新加载
+	log  log.Helper
+}
+
+func NewContentFilter(c *conf.Biz, logger log.Logger) *ContentFilter {
+	f := &ContentFilter{
+		actions:  make(map[string]FilterAction),
+		interval: 30 * time.Second,
+		log:      *log.NewHelper(logger),
+	}
+	filter := c.GetFilter()
+	if filter == nil || filter.DictDir == "" {
+		return f
+	}
+	f.dir = filter.DictDir
+	for category, name := range filter.Actions {
+		action, err := parseFilterAction(name)
+		if err != nil {
+			panic(err)
+		}
+		f.actions[category] = action
+	}
+	if d := filter.ReloadInterval.AsDuration(); d > 0 {
+		f.interval = d
+	}
+	if _, err := f.Reload(); err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Interval 检查词库文件是否变化的间隔
+func (f *ContentFilter) Interval() time.Duration {
+	return f.interval
+}
+
+// Reload 词库文件有变化时重新构建，返回是否重新构建
+// 读取失败时保留原词库
+func (f *ContentFilter) Reload() (bool, error) {
+	if f.dir == "" {
+		return false, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	files, sig, err := dictFiles(f.dir)
+	if err != nil {
+		return false, err
+	}
+	if old := f.dict.Load(); old != nil && old.sig == sig {
+		return false, nil
+	}
+
+	// 同一个词出现在多个分类中时按最严格的分类处理
+	byTerm := make(map[string]dictWord)
+	for _, file := range files {
+		category := strings.TrimSuffix(filepath.Base(file), dictFileExt)
+		action, ok := f.actions[category]
+		if !ok {
+			action = FilterModerate
+		}
+		terms, err := readDictFile(file)
+		if err != nil {
+			return false, err
+		}
+		for _, term := range terms {
+			key := strings.ToLower(term)
+			if w, ok := byTerm[key]; ok && w.action >= action {
+				continue
+			}
+			byTerm[key] = dictWord{term: term, category: category, action: action}
+		}
+	}
+	words := make([]dictWord, 0, len(byTerm))
+	terms := make([]string, 0, len(byTerm))
+	for _, w := range byTerm {
+		words = append(words, w)
+		terms = append(terms, w.term)
+	}
+	f.dict.Store(&sensitiveDict{
+		matcher: ahocorasick.New(terms),
+		words:   words,
+		sig:     sig,
+	})
+	f.log.Infof("Sensitive word dictionary loaded: %d words from %d files", len(words), len(files))
+	return true, nil
+}
+
+// Check 检查多段文本，mask分类的词替换为等长的*，结果中的每个词只出现一次
+func (f *ContentFilter) Check(texts ...string) *FilterResult {
+	res := &FilterResult{Texts: texts}
+	dict := f.dict.Load()
+	if dict == nil {
+		return res
+	}
+	seen := make(map[int]struct{})
+	res.Texts = make([]string, len(texts))
+	for i, text := range texts {
+		res.Texts[i] = text
+		matches := dict.matcher.FindAll(text)
+		if len(matches) == 0 {
+			continue
+		}
+		var runes []rune
+		for _, m := range matches {
+			w := dict.words[m.Index]
+			res.Action = max(res.Action, w.action)
+			if _, ok := seen[m.Index]; !ok {
+				seen[m.Index] = struct{}{}
+				res.Hits = append(res.Hits, model.FilterHit{Category: w.category, Term: w.term})
+			}
+			if w.action != FilterMask {
+				continue
+			}
+			if runes == nil {
+				runes = []rune(text)
+			}
+			for j := m.Start; j < m.End; j++ {
+				runes[j] = '*'
+			}
+		}
+		if runes != nil {
+			res.Texts[i] = string(runes)
+		}
+	}
+	return res
+}
+
+// dictFiles 按文件名排序返回词库文件，同时计算词库的签名
+func dictFiles(dir string) ([]string, string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, "", err
+	}
+	var (
+		files []string
+		sig   strings.Builder
+	)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != dictFileExt {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, "", err
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+		fmt.Fprintf(&sig, "%s:%d:%d;", entry.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	slices.Sort(files)
+	return files, sig.String(), nil
+}
+
+func readDictFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var terms []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		term := strings.TrimSpace(scanner.Text())
+		if term == "" || strings.HasPrefix(term, "#") {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms, scanner.Err()
+}
+
+// recordFilterHits 记录命中的敏感词供版主查看，记录失败只写日志
+func recordFilterHits(ctx context.Context, repo PostRepo, logger *log.Helper, targetType string, targetId, uid int64, res *FilterResult) {
+	if len(res.Hits) == 0 {
+		return
+	}
+	entry := &model.FilterLog{
+		TargetType: targetType,
+		TargetId:   targetId,
+		Uid:        uid,
+		Action:     res.Action.String(),
+		Categories: make([]string, 0, len(res.Hits)),
+		Terms:      make([]string, 0, len(res.Hits)),
+	}
+	for _, hit := range res.Hits {
+		entry.Categories = append(entry.Categories, hit.Category)
+		entry.Terms = append(entry.Terms, hit.Term)
+	}
+	logger.WithContext(ctx).Infow(
+		"[biz]", "Sensitive words matched",
+		"target_type", targetType,
+		"target_id", targetId,
+		"uid", uid,
+		"action", entry.Action,
+		"terms", entry.Terms,
+	)
+	if err := repo.RecordFilterLog(ctx, entry); err != nil {
+		logger.Errorw(
+			"[biz]", "recordFilterHits/RecordFilterLog failed",
+			"err", err,
+			"target_type", targetType,
+			"target_id", targetId,
+		)
+	}
+}
+
+// ListFilterLogs 版主查看敏感词命中记录，targetId不为0时只看该内容的记录
+func (uc *PostUsecase) ListFilterLogs(ctx context.Context, targetType string, targetId, page, pageSize int64) ([]*model.FilterLog, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	logs, err := uc.repo.ListFilterLogs(ctx, targetType, targetId, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListFilterLogs/ListFilterLogs failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,28 @@
+package biz
+
+import (
+	"context"
+	"post-service/internal/model"
+)
+
+// ListMyLikedPosts 按点赞时间倒序列出当前用户点赞过的帖子，已删除的帖子会被忽略
+func (uc *PostUsecase) ListMyLikedPosts(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListMyLikedPosts/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	pids, err := uc.repo.ListLikedPids(ctx, uid, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListMyLikedPosts/ListLikedPids failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return uc.BatchGetPostPreviews(ctx, pids)
+}
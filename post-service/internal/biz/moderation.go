@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ownPendingLimit 列表第一页最多插入的作者自己待审核的帖子数
+const ownPendingLimit = 10
+
+var (
+	errPostNotPending = errors.New("post not existed or already moderated")
+	errReasonRequired = errors.New("reject reason is required")
+)
+
+// initialStatus 开启审核后新帖子需要版主通过才对其他人可见
+func (uc *PostUsecase) initialStatus() int32 {
+	if uc.moderation {
+		return model.StatusPending
+	}
+	return model.StatusNormal
+}
+
+// ListModerationQueue 版主按发帖时间正序查看待审核的帖子
+// boardId为0时查看所有版块，只有全站版主可以查看，版块的版主只能查看自己的版块
+func (uc *PostUsecase) ListModerationQueue(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error) {
+	if boardId == 0 {
+		if err := uc.checkModerator(ctx); err != nil {
+			return nil, err
+		}
+	} else if err := uc.checkBoardModerator(ctx, boardId); err != nil {
+		return nil, err
+	}
+	posts, err := uc.repo.ListPendingPosts(ctx, boardId, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListModerationQueue/ListPendingPosts failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (uc *PostUsecase) ApprovePost(ctx context.Context, pid int64) (*model.Post, error) {
+	return uc.moderatePost(ctx, pid, model.StatusNormal, "")
+}
+
+// RejectPost 驳回后帖子对其他人隐藏，驳回原因会通知作者
+func (uc *PostUsecase) RejectPost(ctx context.Context, pid int64, reason string) (*model.Post, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errReasonRequired
+	}
+	return uc.moderatePost(ctx, pid, model.StatusHidden, reason)
+}
+
+func (uc *PostUsecase) moderatePost(ctx context.Context, pid int64, status int32, reason string) (*model.Post, error) {
+	postInDB, err := uc.repo.GetPostById(ctx, pid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errPostNotPending
+		}
+		uc.log.Errorw(
+			"[biz]", "moderatePost/GetPostById failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	if err := uc.checkBoardModerator(ctx, postInDB.BoardId); err != nil {
+		return nil, err
+	}
+	moderator, err := GetUidFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Moderating post: %d to status: %d by moderator: %d", pid, status, moderator)
+	post, err := uc.repo.ModeratePost(ctx, pid, status, moderator, reason)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "moderatePost/ModeratePost failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	if post == nil {
+		return nil, errPostNotPending
+	}
+	return post, nil
+}
+
+// canView 待审核与隐藏的帖子只有作者与帖子所在版块的版主可见
+func (uc *PostUsecase) canView(ctx context.Context, post *model.Post) (bool, error) {
+	if model.IsVisibleStatus(post.Status) {
+		return true, nil
+	}
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		return false, nil
+	}
+	if uid == post.Uid {
+		return true, nil
+	}
+	ok, err := uc.repo.IsBoardModerator(ctx, uid, post.BoardId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "canView/IsBoardModerator failed",
+			"err", err,
+			"uid", uid,
+		)
+		return false, err
+	}
+	return ok, nil
+}
+
+// withOwnPending 在列表第一页前插入当前用户自己待审核的帖子，其他人的列表中不会出现这些帖子
+// boardId不为0时只插入该版块的帖子，查询失败时只记录日志，返回原列表
+func (uc *PostUsecase) withOwnPending(ctx context.Context, boardId int64, posts []*model.PostPreview) []*model.PostPreview {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		return posts
+	}
+	pending, err := uc.repo.ListPendingPostsByUid(ctx, uid, boardId, ownPendingLimit)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "withOwnPending/ListPendingPostsByUid failed",
+			"err", err,
+			"uid", uid,
+		)
+		return posts
+	}
+	if len(pending) == 0 {
+		return posts
+	}
+	return append(pending, posts...)
+}
+
+// ListNotifications 当前用户的通知，按时间倒序
+func (uc *PostUsecase) ListNotifications(ctx context.Context, page, pageSize int64) ([]*model.Notification, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListNotifications/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	notifications, err := uc.repo.ListNotifications(ctx, uid, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListNotifications/ListNotifications failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// MarkNotificationsRead 将id不大于maxId的通知标记为已读，返回标记的数量
+func (uc *PostUsecase) MarkNotificationsRead(ctx context.Context, maxId int64) (int64, error) {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "MarkNotificationsRead/GetUidFromCtx failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	n, err := uc.repo.MarkNotificationsRead(ctx, uid, maxId)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "MarkNotificationsRead/MarkNotificationsRead failed",
+			"err", err,
+			"uid", uid,
+		)
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// maxPinsPerScope 同一范围内最多置顶的帖子数，置顶帖子全部显示在列表第一页
+const maxPinsPerScope = 10
+
+var (
+	errPinScope      = errors.New("unknown pin scope")
+	errPinNotExisted = errors.New("post is not pinned in scope")
+	errPinTagMissing = errors.New("post does not have the tag")
+
+	// ErrTooManyPins 范围内的置顶数已达上限，在置顶的事务中检查
+	ErrTooManyPins = errors.New("too many pinned posts in scope")
+)
+
+// resolvePinScope 全局置顶没有scopeKey，标签置顶的scopeKey统一为标签的规范名，版块置顶的scopeKey为版块id
+func (uc *PostUsecase) resolvePinScope(ctx context.Context, scope, scopeKey string) (string, error) {
+	switch scope {
+	case model.PinScopeGlobal:
+		return "", nil
+	case model.PinScopeTag:
+		return uc.resolveTag(ctx, scopeKey)
+	case model.PinScopeBoard:
+		boardId, err := strconv.ParseInt(scopeKey, 10, 64)
+		if err != nil || boardId <= 0 {
+			return "", errBoardNotExisted
+		}
+		board, err := uc.GetBoard(ctx, boardId, "")
+		if err != nil {
+			return "", err
+		}
+		return boardPinKey(board.Id), nil
+	}
+	return "", errPinScope
+}
+
+// checkPinPermission 版块置顶由版块的版主管理，全局与标签置顶只有全站版主可以管理
+// scopeKey需要先经过resolvePinScope
+func (uc *PostUsecase) checkPinPermission(ctx context.Context, scope, scopeKey string) error {
+	if scope != model.PinScopeBoard {
+		return uc.checkModerator(ctx)
+	}
+	boardId, err := strconv.ParseInt(scopeKey, 10, 64)
+	if err != nil {
+		return errBoardNotExisted
+	}
+	return uc.checkBoardModerator(ctx, boardId)
+}
+
+// PinPost 版主在全局、标签或版块下置顶帖子，expireAt为nil时不过期，重复置顶时更新过期时间
+func (uc *PostUsecase) PinPost(ctx context.Context, pid int64, scope, scopeKey string, expireAt *time.Time) error {
+	pinner, err := GetUidFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if expireAt != nil && !expireAt.After(now) {
+		return errInvalideParam
+	}
+	scopeKey, err = uc.resolvePinScope(ctx, scope, scopeKey)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkPinPermission(ctx, scope, scopeKey); err != nil {
+		return err
+	}
+	switch scope {
+	case model.PinScopeTag:
+		post, err := uc.GetPostById(ctx, pid)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(post.Tags, scopeKey) {
+			return errPinTagMissing
+		}
+	case model.PinScopeBoard:
+		post, err := uc.GetPostById(ctx, pid)
+		if err != nil {
+			return err
+		}
+		if boardPinKey(post.BoardId) != scopeKey {
+			return errPostNotInBoard
+		}
+	}
+	uc.log.WithContext(ctx).Infof("Pinning post: %d in scope: %s %s by moderator: %d", pid, scope, scopeKey, pinner)
+	ok, err := uc.repo.PinPost(ctx, &model.Pin{
+		Pid:        pid,
+		Scope:      scope,
+		ScopeKey:   scopeKey,
+		PinnerUid:  pinner,
+		ExpireTime: expireAt,
+	}, maxPinsPerScope, now)
+	if err != nil {
+		if errors.Is(err, ErrTooManyPins) {
+			return err
+		}
+		uc.log.Errorw(
+			"[biz]", "PinPost/PinPost failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	if !ok {
+		return errPostNotExisted
+	}
+	return nil
+}
+
+func (uc *PostUsecase) UnpinPost(ctx context.Context, pid int64, scope, scopeKey string) error {
+	scopeKey, err := uc.resolvePinScope(ctx, scope, scopeKey)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkPinPermission(ctx, scope, scopeKey); err != nil {
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("Unpinning post: %d in scope: %s %s", pid, scope, scopeKey)
+	ok, err := uc.repo.UnpinPost(ctx, pid, scope, scopeKey)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "UnpinPost/UnpinPost failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	if !ok {
+		return errPinNotExisted
+	}
+	return nil
+}
+
+// ExpirePins 清理过期的置顶，返回状态从置顶恢复为正常的帖子数
+func (uc *PostUsecase) ExpirePins(ctx context.Context, now time.Time) (int, error) {
+	pids, err := uc.repo.ExpirePins(ctx, now)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ExpirePins/ExpirePins failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	return len(pids), nil
+}
+
+// withPinned 在列表第一页前插入范围内的置顶帖子，并去掉第一页中重复的帖子
+// 其余页不受影响，置顶帖子仍会出现在它原本所在的位置，偏移量与游标的含义不变
+// 查询失败时只记录日志，返回原列表
+func (uc *PostUsecase) withPinned(ctx context.Context, scope, scopeKey string, posts []*model.PostPreview) []*model.PostPreview {
+	pinned, err := uc.repo.ListPinnedPosts(ctx, scope, scopeKey, time.Now())
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "withPinned/ListPinnedPosts failed",
+			"err", err,
+			"scope", scope,
+			"scope_key", scopeKey,
+		)
+		return posts
+	}
+	if len(pinned) == 0 {
+		return posts
+	}
+	seen := make(map[int64]struct{}, len(pinned))
+	for _, post := range pinned {
+		seen[post.Pid] = struct{}{}
+	}
+	for _, post := range posts {
+		if _, ok := seen[post.Pid]; !ok {
+			pinned = append(pinned, post)
+		}
+	}
+	return pinned
+}
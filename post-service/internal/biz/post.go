@@ -8,13 +8,15 @@ import (
 	"post-service/internal/common"
 	"post-service/internal/conf"
 	"post-service/internal/model"
+	"post-service/third_party/redislock"
 	"time"
 
 	"github.com/bwmarrin/snowflake"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	jwtkratos "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/redis/go-redis/v9"
+	"github.com/jackc/pgx/v5"
 	"github.com/sony/gobreaker"
 )
 
@@ -23,33 +25,92 @@ type PostRepo interface {
 	UpdatePost(ctx context.Context, post *model.UpdatePostParam) (*model.Post, error)
 	DeletePost(ctx context.Context, id int64) error
 	GetPostById(ctx context.Context, id int64) (*model.Post, error)
-	ListPostPreviewByTime(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error)
-	ListPostPreviewByHot(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error)
-	ListPostPreviewByHotFallback(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error)
+	BatchGetPostByIds(ctx context.Context, pids []int64) (map[int64]*model.Post, error)
+	ListPostPreviewByTime(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error)
+	ListPostPreviewByHot(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error)
+	ListPostPreviewByHotFallback(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error)
+	ListPostPreviewByTimeCursor(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error)
+	ListPostPreviewByHotCursor(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error)
+	ListPostPreviewByHotCursorFallback(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error)
+	ListPostPreviewByLeaderboard(ctx context.Context, period string, page, pageSize int64) ([]*model.PostPreview, error)
+	ListPostPreviewByLeaderboardFallback(ctx context.Context, since *time.Time, weights model.RankWeights, page, pageSize int64) ([]*model.PostPreview, error)
 
 	GetUserByUid(ctx context.Context, uid int64) (*model.User, error)
+	TouchSession(ctx context.Context, sid string) (bool, error)
+	IsSessionValid(ctx context.Context, uid int64, sid string) (bool, error)
 
 	ExistedRsetMem(ctx context.Context, key string, mem any) (bool, error)
-	AddRsetMem(ctx context.Context, key string, mem any) error
-	DelRsetMem(ctx context.Context, key string, mem any) error
-
-	RedisClient() *redis.Client
-}
-
-type redisLocker struct {
-	Client *redis.Client
-	ttl    time.Duration
-	id     int64
+	AddRsetMem(ctx context.Context, key string, mem any) (bool, error)
+	DelRsetMem(ctx context.Context, key string, mem any) (bool, error)
+
+	ResolveTagAliases(ctx context.Context, names []string) (map[string]string, error)
+	UpdateTagCounts(ctx context.Context, added, removed []string) error
+	IncrTagTrend(ctx context.Context, day time.Time, tags []string, score float64) error
+	ListPostPreviewByTag(ctx context.Context, tag string, page, pageSize int64) ([]*model.PostPreview, error)
+	ListTags(ctx context.Context, prefix string, page, pageSize int64) ([]*model.Tag, error)
+	ListTrendingTags(ctx context.Context, now time.Time, days, limit int64) ([]*model.Tag, error)
+	RecordView(ctx context.Context, pid int64, visitor string, window time.Duration) (bool, error)
+	PublishLikeEvent(ctx context.Context, event *model.LikeEvent) error
+	HasLikedPost(ctx context.Context, pid, uid int64) (bool, error)
+	AddPostLikeRecord(ctx context.Context, pid, uid int64) (bool, error)
+	DelPostLikeRecord(ctx context.Context, pid, uid int64) (bool, error)
+	ListLikedPids(ctx context.Context, uid, page, pageSize int64) ([]int64, error)
+	SearchPosts(ctx context.Context, param *model.SearchPostsParam) ([]*model.SearchHit, error)
+
+	SaveDraft(ctx context.Context, param *model.SaveDraftParam, newDid int64) (*model.Draft, error)
+	GetDraft(ctx context.Context, uid, did int64) (*model.Draft, error)
+	ListDrafts(ctx context.Context, uid, page, pageSize int64) ([]*model.Draft, error)
+	ListDueDrafts(ctx context.Context, now time.Time, limit int64) ([]*model.Draft, error)
+	DeleteDraft(ctx context.Context, uid, did int64) (bool, error)
+	PublishDraft(ctx context.Context, did int64, post *model.CreatePostParam) (*model.Post, error)
+
+	ListRevisions(ctx context.Context, pid, page, pageSize int64) ([]*model.Revision, error)
+	GetRevision(ctx context.Context, pid int64, rev int32) (*model.Revision, error)
+	IsModerator(ctx context.Context, uid int64) (bool, error)
+
+	ListPendingPosts(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error)
+	ListPendingPostsByUid(ctx context.Context, uid, boardId, limit int64) ([]*model.PostPreview, error)
+	ModeratePost(ctx context.Context, pid int64, status int32, moderator int64, reason string) (*model.Post, error)
+	ListNotifications(ctx context.Context, uid, page, pageSize int64) ([]*model.Notification, error)
+	MarkNotificationsRead(ctx context.Context, uid, maxId int64) (int64, error)
+
+	RecordFilterLog(ctx context.Context, entry *model.FilterLog) error
+	ListFilterLogs(ctx context.Context, targetType string, targetId, page, pageSize int64) ([]*model.FilterLog, error)
+
+	GetCommentTarget(ctx context.Context, cid int64) (int64, int32, error)
+	ReportContent(ctx context.Context, report *model.Report, hideThreshold, hideMinReporters int32) (*model.ReportResult, error)
+	HandleReportCase(ctx context.Context, caseId int64, status int32, handler int64, outcome string) (*model.ReportCase, error)
+	ListReportCases(ctx context.Context, status int32, page, pageSize int64) ([]*model.ReportCase, error)
+	ListReports(ctx context.Context, caseId, page, pageSize int64) ([]*model.Report, error)
+
+	PinPost(ctx context.Context, pin *model.Pin, maxPins int64, now time.Time) (bool, error)
+	UnpinPost(ctx context.Context, pid int64, scope, scopeKey string) (bool, error)
+	ListPinnedPosts(ctx context.Context, scope, scopeKey string, now time.Time) ([]*model.PostPreview, error)
+	ExpirePins(ctx context.Context, now time.Time) ([]int64, error)
+
+	CreateBoard(ctx context.Context, board *model.Board) (*model.Board, error)
+	UpdateBoard(ctx context.Context, param *model.UpdateBoardParam) (*model.Board, error)
+	GetBoard(ctx context.Context, id int64, slug string) (*model.Board, error)
+	ListBoards(ctx context.Context) ([]*model.Board, error)
+	AddBoardModerator(ctx context.Context, boardId, uid int64) error
+	RemoveBoardModerator(ctx context.Context, boardId, uid int64) (bool, error)
+	IsBoardModerator(ctx context.Context, uid, boardId int64) (bool, error)
 }
 
 type PostUsecase struct {
-	repo    PostRepo
-	rlocker *redisLocker
-	node    *snowflake.Node
-	log     log.Helper
+	repo   PostRepo
+	node   *snowflake.Node
+	ranker *HotRanker
+	filter *ContentFilter
+	locker *redislock.Client
+	log    log.Helper
+
+	moderation bool // 新帖子是否需要审核
+	report     reportPolicy
 }
 
-func NewPostUsecase(c *conf.Biz, repo PostRepo, logger log.Logger) *PostUsecase {
+// NewSfNode 帖子与评论共用同一个雪花节点，避免同一机器号下生成重复id
+func NewSfNode(c *conf.Biz) *snowflake.Node {
 	start, err := time.Parse("2006-01-02 15:04:05", c.App.StartTime)
 	if err != nil {
 		panic(err)
@@ -59,19 +120,26 @@ func NewPostUsecase(c *conf.Biz, repo PostRepo, logger log.Logger) *PostUsecase
 	if err != nil {
 		panic(err)
 	}
+	return node
+}
 
-	rlocker := &redisLocker{Client: repo.RedisClient(), ttl: 5 * time.Second, id: c.App.Machine_ID}
-
+func NewPostUsecase(c *conf.Biz, repo PostRepo, node *snowflake.Node, ranker *HotRanker, filter *ContentFilter, locker *redislock.Client, logger log.Logger) *PostUsecase {
 	return &PostUsecase{
-		repo:    repo,
-		node:    node,
-		log:     *log.NewHelper(logger),
-		rlocker: rlocker,
+		repo:   repo,
+		node:   node,
+		ranker: ranker,
+		filter: filter,
+		locker: locker,
+		log:    *log.NewHelper(logger),
+
+		moderation: c.GetModeration().GetEnabled(),
+		report:     newReportPolicy(c),
 	}
 }
 
 type Claims struct {
-	UID int64 `json:"uid"`
+	UID int64  `json:"uid"`
+	SID string `json:"sid,omitempty"` // 会话id，由user服务签发
 	jwt.RegisteredClaims
 }
 
@@ -80,6 +148,11 @@ const (
 	StrHot  = "hot"
 )
 
+const (
+	postLockWait = 3 * time.Second // 等待其他编辑释放锁的最长时间
+	postLockTTL  = 5 * time.Second // 持有期间会自动续期
+)
+
 var (
 	errTokenParase = errors.New("token is invalid")
 	errToekenType  = errors.New("token type is invalid")
@@ -87,6 +160,16 @@ var (
 	errPostNotExisted = errors.New("post not existed")
 
 	errInvalideParam = errors.New("invalid Param")
+
+	errVersionRequired = errors.New("version is required")
+
+	// errPostLocked 等待同一帖子的其他编辑超时
+	errPostLocked = kerrors.Conflict("POST_LOCKED", "post is being edited, retry later")
+
+	errSessionRevoked = errors.New("session is revoked")
+
+	// ErrPostVersionConflict 帖子在读取之后被他人修改，调用方需要重新读取后再提交
+	ErrPostVersionConflict = kerrors.Conflict("POST_VERSION_CONFLICT", "post has been modified, reload and retry")
 )
 
 func (uc *PostUsecase) CreatePost(ctx context.Context, param *model.CreatePostParam) (*model.Post, error) {
@@ -112,12 +195,33 @@ func (uc *PostUsecase) CreatePost(ctx context.Context, param *model.CreatePostPa
 		)
 		return nil, err
 	}
+	if err := uc.checkBoardPostable(ctx, uid, param.BoardId); err != nil {
+		return nil, err
+	}
+
+	// 敏感词过滤
+	filtered := uc.filter.Check(param.Title, param.Content)
+	if filtered.Action == FilterReject {
+		recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, 0, uid, filtered)
+		return nil, errSensitiveContent
+	}
+	param.Title, param.Content = filtered.Texts[0], filtered.Texts[1]
 
 	// 补全param
+	tags, err := uc.normalizeTags(ctx, param.Tags)
+	if err != nil {
+		return nil, err
+	}
+	param.Tags = tags
 	param.Uid = uid
 	param.Author = user.Username
-	param.Score = time.Now().UnixNano()
+	now := time.Now()
+	param.Score = uc.ranker.Score(0, 0, 0, now, now)
 	param.Pid = uc.node.Generate().Int64()
+	param.Status = uc.initialStatus()
+	if filtered.Action == FilterModerate {
+		param.Status = model.StatusPending
+	}
 
 	// 同步进数据库中
 	uc.log.WithContext(ctx).Infof("Creating post with title: %s by userID: %d", param.Title, uid)
@@ -129,6 +233,9 @@ func (uc *PostUsecase) CreatePost(ctx context.Context, param *model.CreatePostPa
 		)
 		return nil, err
 	}
+	recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, post.Pid, uid, filtered)
+	uc.updateTagCounts(ctx, post.Tags, nil)
+	uc.incrTagTrend(ctx, post.Tags, tagScoreOnCreate)
 
 	return post, nil
 }
@@ -142,40 +249,27 @@ func (uc *PostUsecase) UpdatePost(ctx context.Context, param *model.UpdatePostPa
 		)
 		return nil, errPostNotExisted
 	}
-	// 检查该post是否存在
-	// 上锁
-	keyLock := fmt.Sprintf(common.RKeyPostLock, param.Pid)
-	maxTry := 11
-	for i := 0; i < maxTry; i++ {
-		if i == maxTry-1 {
-			// 获取锁失败
-			uc.log.Errorw(
-				"[biz]", "UpdatePost/Lock failed",
-				"err", fmt.Errorf("failed to acquire lock after %d attempts", maxTry),
-				"pid", param.Pid,
-			)
-			return nil, fmt.Errorf("failed to acquire lock after %d attempts", maxTry)
-		}
-		waitTime := 1
-		ok, err := uc.rlocker.Lock(ctx, keyLock)
+	// 必须基于读到的版本修改，避免覆盖读取之后他人的修改（例如审核结果）
+	if param.Version == 0 {
+		return nil, errVersionRequired
+	}
+	editor, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "UpdatePost/GetUidFromCtx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	param.Editor = editor
+	if param.Tags != nil {
+		tags, err := uc.normalizeTags(ctx, param.Tags)
 		if err != nil {
-			// 上锁时出错
-			uc.log.Errorw(
-				"[biz]", "UpdatePost/Lock failed",
-				"err", err,
-			)
 			return nil, err
 		}
-		if !ok {
-			// 锁正在使用，等待指数秒后重试
-			time.Sleep(time.Duration(waitTime) * time.Millisecond)
-			waitTime *= 2
-			continue
-		}
-		break
+		param.Tags = tags
 	}
-	// 成功获取锁
-
+	// 检查该post是否存在
 	postInDB, err := uc.repo.GetPostById(ctx, param.Pid)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -201,49 +295,90 @@ func (uc *PostUsecase) UpdatePost(ctx context.Context, param *model.UpdatePostPa
 		)
 		return nil, errPostNotExisted
 	}
-
-	// 如果存在，则填补剩余为空的字段
-	if param.Title == nil {
-		param.Title = &postInDB.Title
+	// 修改状态属于审核操作，只有帖子所在版块的版主可以执行
+	// postInDB可能来自缓存，不能据此判断状态是否变化
+	if param.Status != nil {
+		if err := uc.checkBoardModerator(ctx, postInDB.BoardId); err != nil {
+			return nil, err
+		}
 	}
-	if param.Content == nil {
-		param.Content = &postInDB.Content
+
+	// 只过滤本次修改的标题与正文
+	var title, content string
+	if param.Title != nil {
+		title = *param.Title
 	}
-	if param.Status == nil {
-		param.Status = &postInDB.Status
+	if param.Content != nil {
+		content = *param.Content
 	}
-	if param.Score == nil {
-		param.Score = &postInDB.Score
+	filtered := uc.filter.Check(title, content)
+	if filtered.Action == FilterReject {
+		recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, param.Pid, editor, filtered)
+		return nil, errSensitiveContent
 	}
-	if param.Tags == nil {
-		param.Tags = postInDB.Tags
+	if param.Title != nil {
+		param.Title = &filtered.Texts[0]
 	}
-	if param.Like == nil {
-		param.Like = &postInDB.Like
+	if param.Content != nil {
+		param.Content = &filtered.Texts[1]
 	}
 
-	uc.log.WithContext(ctx).Infof("Updating post with PID: %d", param.Pid)
-	post, err := uc.repo.UpdatePost(ctx, param)
-	if err := uc.rlocker.Unlock(ctx, keyLock); err != nil {
+	// 同一帖子的编辑依次执行，锁过期后旧持有者的写入由栅栏令牌拒绝
+	lockCtx, cancel := context.WithTimeout(ctx, postLockWait)
+	lock, err := uc.locker.Lock(lockCtx, fmt.Sprintf(common.RKeyPostLock, param.Pid), postLockTTL)
+	cancel()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, errPostLocked
+		}
 		uc.log.Errorw(
-			"[biz]", "UpdatePost/Unlock failed",
+			"[biz]", "UpdatePost/Lock failed",
 			"err", err,
+			"pid", param.Pid,
 		)
 		return nil, err
 	}
+	defer func() {
+		if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+			uc.log.Errorw(
+				"[biz]", "UpdatePost/Unlock failed",
+				"err", err,
+				"pid", param.Pid,
+			)
+		}
+	}()
+
+	// 未修改的字段由data层以加锁读到的行补全
+	param.Moderate = filtered.Action == FilterModerate
+	uc.log.WithContext(ctx).Infof("Updating post with PID: %d", param.Pid)
+	post, err := uc.repo.UpdatePost(lock.WithFence(ctx), param)
 	if err != nil {
+		if errors.Is(err, ErrPostVersionConflict) {
+			return nil, err
+		}
+		// 锁在写入前丢失，其他编辑可能已经写入
+		if errors.Is(err, redislock.ErrFenceStale) {
+			return nil, ErrPostVersionConflict
+		}
+		// 读取之后帖子被删除
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errPostNotExisted
+		}
 		uc.log.Errorw(
 			"[biz]", "UpdatePost/UpdatePost failed",
 			"err", err,
 		)
 		return nil, err
 	}
+	recordFilterHits(ctx, uc.repo, &uc.log, model.TargetPost, post.Pid, editor, filtered)
+	added, removed := diffTags(postInDB.Tags, post.Tags)
+	uc.updateTagCounts(ctx, added, removed)
 	return post, nil
 }
 
 func (uc *PostUsecase) DeletePost(ctx context.Context, id int64) error {
 	// 检查是否存在，如果不存在则返回错误
-	_, err := uc.repo.GetPostById(ctx, id)
+	postInDB, err := uc.repo.GetPostById(ctx, id)
 	if err != nil {
 		// NotFound与其他错误
 		if errors.Is(err, sql.ErrNoRows) {
@@ -268,6 +403,7 @@ func (uc *PostUsecase) DeletePost(ctx context.Context, id int64) error {
 		)
 		return err
 	}
+	uc.updateTagCounts(ctx, nil, postInDB.Tags)
 
 	return nil
 }
@@ -282,10 +418,78 @@ func (uc *PostUsecase) GetPostById(ctx context.Context, id int64) (*model.Post,
 		)
 		return nil, err
 	}
+	ok, err := uc.canView(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errPostNotExisted
+	}
 	return post, nil
 }
 
-func (uc *PostUsecase) ListPostPreview(ctx context.Context, page, pageSize int64, searchType *string) ([]*model.PostPreview, error) {
+func (uc *PostUsecase) GetPostPreview(ctx context.Context, pid int64) (*model.PostPreview, error) {
+	post, err := uc.repo.GetPostById(ctx, pid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return nil, errPostNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "GetPostPreview/GetPostById failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	ok, err := uc.canView(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errPostNotExisted
+	}
+	return post.ToPreview(), nil
+}
+
+// BatchGetPostPreviews 批量获取帖子简要信息，结果按pids顺序返回，不存在或不可见的帖子会被忽略
+func (uc *PostUsecase) BatchGetPostPreviews(ctx context.Context, pids []int64) ([]*model.PostPreview, error) {
+	seen := make(map[int64]struct{}, len(pids))
+	unique := make([]int64, 0, len(pids))
+	for _, pid := range pids {
+		if _, ok := seen[pid]; ok {
+			continue
+		}
+		seen[pid] = struct{}{}
+		unique = append(unique, pid)
+	}
+
+	posts, err := uc.repo.BatchGetPostByIds(ctx, unique)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "BatchGetPostPreviews/BatchGetPostByIds failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	previews := make([]*model.PostPreview, 0, len(unique))
+	for _, pid := range unique {
+		post, ok := posts[pid]
+		if !ok {
+			continue
+		}
+		visible, err := uc.canView(ctx, post)
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			previews = append(previews, post.ToPreview())
+		}
+	}
+	return previews, nil
+}
+
+// ListPostPreview boardId为0时列出所有版块的帖子，否则只列出该版块的帖子并使用版块的置顶与热度排行榜
+func (uc *PostUsecase) ListPostPreview(ctx context.Context, boardId, page, pageSize int64, searchType *string) ([]*model.PostPreview, error) {
 	if searchType == nil {
 		// 默认按时间查询
 		searchType = new(string)
@@ -294,7 +498,7 @@ func (uc *PostUsecase) ListPostPreview(ctx context.Context, page, pageSize int64
 	switch *searchType {
 	case StrTime:
 		uc.log.WithContext(ctx).Infof("Listing posts with page order by time: %d, pageSize: %d ", page, pageSize)
-		posts, err := uc.repo.ListPostPreviewByTime(ctx, page, pageSize)
+		posts, err := uc.repo.ListPostPreviewByTime(ctx, boardId, page, pageSize)
 		if err != nil {
 			uc.log.Errorw(
 				"[biz]", "ListPostPreview/ListPostPreview failed",
@@ -302,17 +506,20 @@ func (uc *PostUsecase) ListPostPreview(ctx context.Context, page, pageSize int64
 			)
 			return nil, err
 		}
+		if page == 0 {
+			posts = uc.withListHead(ctx, boardId, posts)
+		}
 		return posts, nil
 	case StrHot:
 		uc.log.WithContext(ctx).Infof("Listing posts with page order by hot: %d, pageSize: %d ", page, pageSize)
-		posts, err := uc.repo.ListPostPreviewByHot(ctx, page, pageSize)
+		posts, err := uc.repo.ListPostPreviewByHot(ctx, boardId, page, pageSize)
 		if err != nil {
 			uc.log.Errorw(
 				"[biz]", "ListPostPreview/ListPostByHot failed",
 				"err", err,
 			)
 			if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
-				fallPosts, err := uc.repo.ListPostPreviewByHotFallback(ctx, page, pageSize)
+				fallPosts, err := uc.repo.ListPostPreviewByHotFallback(ctx, boardId, page, pageSize)
 				if err != nil {
 					uc.log.Errorw(
 						"biz", "ListPostPreview/ListPostPreviewByHotFallback failed",
@@ -320,9 +527,13 @@ func (uc *PostUsecase) ListPostPreview(ctx context.Context, page, pageSize int64
 					)
 					return nil, err
 				}
-				return fallPosts, nil
+				posts = fallPosts
+			} else {
+				return nil, err
 			}
-			return nil, err
+		}
+		if page == 0 {
+			posts = uc.withListHead(ctx, boardId, posts)
 		}
 		return posts, nil
 	}
@@ -333,6 +544,55 @@ func (uc *PostUsecase) ListPostPreview(ctx context.Context, page, pageSize int64
 	return nil, errors.New("unkown error")
 }
 
+// ListPostPreviewByCursor 游标分页，返回下一页的游标，没有更多数据时游标为空，boardId与ListPostPreview一致
+func (uc *PostUsecase) ListPostPreviewByCursor(ctx context.Context, boardId int64, cursorStr string, pageSize int64, searchType *string) ([]*model.PostPreview, string, error) {
+	if searchType == nil {
+		// 默认按时间查询
+		searchType = new(string)
+		*searchType = StrTime
+	}
+	if *searchType != StrTime && *searchType != StrHot {
+		return nil, "", errInvalideParam
+	}
+	cursor, err := decodeCursor(cursorStr, *searchType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		posts []*model.PostPreview
+		next  *model.PostCursor
+	)
+	switch *searchType {
+	case StrTime:
+		posts, next, err = uc.repo.ListPostPreviewByTimeCursor(ctx, boardId, cursor, pageSize)
+	case StrHot:
+		posts, next, err = uc.repo.ListPostPreviewByHotCursor(ctx, boardId, cursor, pageSize)
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			uc.log.Errorw(
+				"[biz]", "ListPostPreviewByCursor/ListPostPreviewByHotCursor failed",
+				"err", err,
+			)
+			posts, next, err = uc.repo.ListPostPreviewByHotCursorFallback(ctx, boardId, cursor, pageSize)
+		}
+	}
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListPostPreviewByCursor failed",
+			"err", err,
+			"searchType", *searchType,
+		)
+		return nil, "", err
+	}
+	if cursor == nil {
+		posts = uc.withListHead(ctx, boardId, posts)
+	}
+	if next == nil {
+		return posts, "", nil
+	}
+	return posts, encodeCursor(next), nil
+}
+
 func (uc *PostUsecase) AddPostLike(ctx context.Context, pid int64, like int32) (*model.Post, error) {
 	// 检查帖子是否存在
 	postInDB, err := uc.repo.GetPostById(ctx, pid)
@@ -360,13 +620,21 @@ func (uc *PostUsecase) AddPostLike(ctx context.Context, pid int64, like int32) (
 		)
 		return nil, errPostNotExisted
 	}
+	// 不可见的帖子按不存在处理
+	ok, err := uc.canView(ctx, postInDB)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errPostNotExisted
+	}
 
 	// 存在则更新点赞情况
 	// like = 1时
 	// 如果已经点赞过，再次点赞则不做任何操作
-	// 如果未点赞过，记录该点赞并更新分数到redis与pg中
+	// 如果未点赞过，记录该点赞并发送点赞事件
 	// like = 0时
-	// 如果已经点赞过，取消点赞并更新分数到redis与pg中
+	// 如果已经点赞过，取消点赞并发送点赞事件
 	// 如果未点赞过，则不做任何操作
 	uid, err := GetUidFromCtx(ctx)
 	if err != nil {
@@ -377,75 +645,97 @@ func (uc *PostUsecase) AddPostLike(ctx context.Context, pid int64, like int32) (
 		return nil, err
 	}
 
-	key := fmt.Sprintf(common.RKeyPostLike, pid)
-	// 取消点赞操作，检查是否点过赞
-	ok, err := uc.repo.ExistedRsetMem(ctx, key, uid)
-	if err != nil {
+	if like != 0 && like != 1 {
 		uc.log.Errorw(
-			"[biz]", "AddPostLike/ExistedRsetMem failed",
-			"err", err,
+			"[biz]", "AddPostLike/Invalid like value",
+			"err", errInvalideParam,
+			"like", like,
 		)
-		return nil, err
+		return nil, errInvalideParam
 	}
 
-	var newlike int64
+	// 点赞记录以pg中的post_like为准，redis中的集合只用于快速判断重复点赞
+	var (
+		delta   int64
+		changed bool
+	)
 	if like == 1 {
-		// 点赞操作，检查是否点过赞
-		if ok {
-			return postInDB, nil // 已经点过赞，直接返回
-		}
-		// 未点过赞，更新redis
-		if err := uc.repo.AddRsetMem(ctx, key, uid); err != nil {
+		liked, err := uc.repo.HasLikedPost(ctx, pid, uid)
+		if err != nil {
 			uc.log.Errorw(
-				"[biz]", "AddPostLike/AddRsetMem failed",
+				"[biz]", "AddPostLike/HasLikedPost failed",
 				"err", err,
 				"pid", pid,
 			)
 			return nil, err
 		}
-		newlike = postInDB.Like + 1
-	}
-	if like == 0 {
-		if !ok {
-			return postInDB, nil // 未点过赞，直接返回
+		if liked {
+			return postInDB, nil // 已经点过赞，直接返回
 		}
-		// 已经点过赞，更新redis
-		if err := uc.repo.DelRsetMem(ctx, key, uid); err != nil {
+		changed, err = uc.repo.AddPostLikeRecord(ctx, pid, uid)
+		if err != nil {
 			uc.log.Errorw(
-				"[biz]", "AddPostLike/DelRsetMem failed",
+				"[biz]", "AddPostLike/AddPostLikeRecord failed",
 				"err", err,
 				"pid", pid,
 			)
 			return nil, err
 		}
-		newlike = postInDB.Like - 1
-		if newlike < 0 {
-			newlike = 0 // 确保点赞数不为负数
+		delta = 1
+	} else {
+		changed, err = uc.repo.DelPostLikeRecord(ctx, pid, uid)
+		if err != nil {
+			uc.log.Errorw(
+				"[biz]", "AddPostLike/DelPostLikeRecord failed",
+				"err", err,
+				"pid", pid,
+			)
+			return nil, err
 		}
+		delta = -1
 	}
-	if like != 0 && like != 1 {
-		uc.log.Errorw(
-			"[biz]", "AddPostLike/Invalid like value",
-			"err", errInvalideParam,
-			"like", like,
-		)
-		return nil, errInvalideParam
+	if !changed {
+		return postInDB, nil // 重复点赞或未点过赞，直接返回
 	}
 
-	// 注意这里的操作可能存在redis与数据库不同步的问题，可以使用消息队列解决，兜底方案为重新同步
-	// 处理应该放在update中
-	replyPost, err := uc.UpdatePost(ctx, &model.UpdatePostParam{
-		Pid:  pid,
-		Like: &newlike,
-	})
-	if err != nil {
+	// 点赞数与热度由job消费点赞事件后写入pg，发送失败时撤销点赞记录
+	event := &model.LikeEvent{
+		EventId:    uc.node.Generate().Int64(),
+		Pid:        pid,
+		Uid:        uid,
+		Delta:      delta,
+		CreateTime: time.Now(),
+	}
+	if err := uc.repo.PublishLikeEvent(ctx, event); err != nil {
 		uc.log.Errorw(
-			"[biz]", "AddPostLike/UpdatePost failed",
+			"[biz]", "AddPostLike/PublishLikeEvent failed",
 			"err", err,
 			"pid", pid,
 		)
+		var rollbackErr error
+		if delta > 0 {
+			_, rollbackErr = uc.repo.DelPostLikeRecord(ctx, pid, uid)
+		} else {
+			_, rollbackErr = uc.repo.AddPostLikeRecord(ctx, pid, uid)
+		}
+		if rollbackErr != nil {
+			// 无法撤销时由对账任务修复点赞数
+			uc.log.Errorw(
+				"[biz]", "AddPostLike/Rollback failed",
+				"err", rollbackErr,
+				"pid", pid,
+			)
+		}
+		return nil, err
+	}
+	uc.incrTagTrend(ctx, postInDB.Tags, float64(delta)*tagScoreOnLike)
+
+	// 返回预期的点赞数，pg中的数据稍后更新
+	postInDB.Like += delta
+	if postInDB.Like < 0 {
+		postInDB.Like = 0 // 确保点赞数不为负数
 	}
-	return replyPost, nil
+	return postInDB, nil
 }
 
 func GetUidFromCtx(ctx context.Context) (int64, error) {
@@ -460,13 +750,41 @@ func GetUidFromCtx(ctx context.Context) (int64, error) {
 	return claims.UID, nil
 }
 
-// 查后改锁
-func (l *redisLocker) Lock(ctx context.Context, key string) (bool, error) {
-	ok, err := l.Client.SetNX(ctx, key, l.id, l.ttl).Result()
-	return ok, err
-}
-
-func (l *redisLocker) Unlock(ctx context.Context, key string) error {
-	_, err := l.Client.Del(ctx, key).Result()
-	return err
+// CheckSession 校验jwt对应的会话是否已被吊销
+func (uc *PostUsecase) CheckSession(ctx context.Context) error {
+	claimsToken, ok := jwtkratos.FromContext(ctx)
+	if !ok {
+		return errTokenParase
+	}
+	claims, ok := claimsToken.(*Claims)
+	if !ok {
+		return errToekenType
+	}
+	if claims.SID == "" {
+		// 会话功能上线前签发的token没有sid，在过期前继续放行
+		return nil
+	}
+	ok, err := uc.repo.TouchSession(ctx, claims.SID)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CheckSession/TouchSession failed",
+			"err", err,
+		)
+	}
+	if ok {
+		return nil
+	}
+	// redis中没有该会话时以数据库为准
+	ok, err = uc.repo.IsSessionValid(ctx, claims.UID, claims.SID)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CheckSession/IsSessionValid failed",
+			"err", err,
+		)
+		return err
+	}
+	if !ok {
+		return errSessionRevoked
+	}
+	return nil
 }
@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"post-service/internal/conf"
+	"post-service/internal/model"
+	"time"
+
+	"github.com/sony/gobreaker"
+)
+
+const (
+	// 热度是小数，放大后取整存入score列与排行榜
+	hotScoreScale = 1e6
+
+	PeriodDay  = "day"
+	PeriodWeek = "week"
+	PeriodAll  = "all"
+
+	LeaderboardSize = 1000 // 每个榜单保留的帖子数
+)
+
+// HotRanker 按Hacker News的重力公式计算帖子热度，发布时间越久热度越低
+type HotRanker struct {
+	gravity  float64
+	weights  model.RankWeights
+	interval time.Duration
+	window   time.Duration
+}
+
+func NewHotRanker(c *conf.Biz) *HotRanker {
+	r := &HotRanker{
+		gravity:  1.8,
+		weights:  model.RankWeights{Like: 1, Comment: 2, View: 0.05},
+		interval: 5 * time.Minute,
+		window:   7 * 24 * time.Hour,
+	}
+	rank := c.GetRank()
+	if rank == nil {
+		return r
+	}
+	if rank.Gravity > 0 {
+		r.gravity = rank.Gravity
+	}
+	if rank.LikeWeight > 0 || rank.CommentWeight > 0 || rank.ViewWeight > 0 {
+		r.weights = model.RankWeights{Like: rank.LikeWeight, Comment: rank.CommentWeight, View: rank.ViewWeight}
+	}
+	if d := rank.RescoreInterval.AsDuration(); d > 0 {
+		r.interval = d
+	}
+	if d := rank.RescoreWindow.AsDuration(); d > 0 {
+		r.window = d
+	}
+	return r
+}
+
+func (r *HotRanker) Weights() model.RankWeights {
+	return r.weights
+}
+
+// Interval 重新计算热度的间隔
+func (r *HotRanker) Interval() time.Duration {
+	return r.interval
+}
+
+// Window 超出该时间段的帖子热度已经很低，不再重新计算
+func (r *HotRanker) Window() time.Duration {
+	return r.window
+}
+
+// Points 加权互动数，也是榜单的排序依据
+func (r *HotRanker) Points(like, comment, view int64) float64 {
+	return float64(like)*r.weights.Like + float64(comment)*r.weights.Comment + float64(view)*r.weights.View
+}
+
+// Score 热度 = (加权互动数 + 1) / (发布小时数 + 2) ^ gravity
+// 加1保证没有互动的新帖也有热度
+func (r *HotRanker) Score(like, comment, view int64, createTime, now time.Time) int64 {
+	hours := now.Sub(createTime).Hours()
+	if hours < 0 {
+		hours = 0
+	}
+	score := (r.Points(like, comment, view) + 1) / math.Pow(hours+2, r.gravity)
+	return int64(score * hotScoreScale)
+}
+
+// Since 返回榜单统计的起始时间，总榜返回nil
+func (r *HotRanker) Since(period string, now time.Time) (*time.Time, error) {
+	var since time.Time
+	switch period {
+	case PeriodDay:
+		since = now.Add(-24 * time.Hour)
+	case PeriodWeek:
+		since = now.Add(-7 * 24 * time.Hour)
+	case PeriodAll:
+		return nil, nil
+	default:
+		return nil, errInvalideParam
+	}
+	return &since, nil
+}
+
+// ListLeaderboard 日榜、周榜与总榜，榜单由定时任务重建，redis熔断时回退到pg实时计算
+func (uc *PostUsecase) ListLeaderboard(ctx context.Context, period string, page, pageSize int64) ([]*model.PostPreview, error) {
+	since, err := uc.ranker.Since(period, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if page*pageSize >= LeaderboardSize {
+		return []*model.PostPreview{}, nil
+	}
+
+	posts, err := uc.repo.ListPostPreviewByLeaderboard(ctx, period, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListLeaderboard/ListPostPreviewByLeaderboard failed",
+			"err", err,
+			"period", period,
+		)
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			fallPosts, err := uc.repo.ListPostPreviewByLeaderboardFallback(ctx, since, uc.ranker.Weights(), page, pageSize)
+			if err != nil {
+				uc.log.Errorw(
+					"[biz]", "ListLeaderboard/ListPostPreviewByLeaderboardFallback failed",
+					"err", err,
+					"period", period,
+				)
+				return nil, err
+			}
+			return fallPosts, nil
+		}
+		return nil, err
+	}
+	return posts, nil
+}
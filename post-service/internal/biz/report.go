@@ -0,0 +1,189 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"post-service/internal/conf"
+	"post-service/internal/model"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	errReportReason     = errors.New("unknown report reason")
+	errReportDuplicated = errors.New("content already reported")
+	errReportTarget     = errors.New("reported content not existed")
+	errReportCaseClosed = errors.New("report case not existed or already handled")
+)
+
+// reportPolicy 举报原因的权重与自动隐藏的阈值
+// 新注册的账号也能举报，只看权重时两个账号就能隐藏任意内容，因此同时要求举报人数
+type reportPolicy struct {
+	weights          map[string]int32
+	hideThreshold    int32
+	hideMinReporters int32
+}
+
+func newReportPolicy(c *conf.Biz) reportPolicy {
+	p := reportPolicy{
+		weights:          map[string]int32{"spam": 1, "abuse": 2, "porn": 3, "illegal": 3, "other": 1},
+		hideThreshold:    5,
+		hideMinReporters: 3,
+	}
+	report := c.GetReport()
+	if report == nil {
+		return p
+	}
+	if len(report.ReasonWeights) > 0 {
+		p.weights = report.ReasonWeights
+	}
+	p.hideThreshold = report.HideThreshold
+	if report.HideMinReporters > 0 {
+		p.hideMinReporters = report.HideMinReporters
+	}
+	return p
+}
+
+// ReportContent 举报帖子或评论，同一用户对同一内容只能举报一次
+func (uc *PostUsecase) ReportContent(ctx context.Context, report *model.Report) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ReportContent/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	if report.TargetType != model.TargetPost && report.TargetType != model.TargetComment {
+		return errInvalideParam
+	}
+	weight, ok := uc.report.weights[report.Reason]
+	if !ok {
+		return errReportReason
+	}
+	report.ReporterUid = uid
+	report.Weight = weight
+	report.Detail = strings.TrimSpace(report.Detail)
+	// 只能举报自己能看到的内容
+	if err := uc.checkReportTargetVisible(ctx, report); err != nil {
+		return err
+	}
+
+	res, err := uc.repo.ReportContent(ctx, report, uc.report.hideThreshold, uc.report.hideMinReporters)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ReportContent/ReportContent failed",
+			"err", err,
+			"target_type", report.TargetType,
+			"target_id", report.TargetId,
+		)
+		return err
+	}
+	if res == nil {
+		return errReportTarget
+	}
+	if res.Duplicated {
+		return errReportDuplicated
+	}
+	if res.Hidden {
+		uc.log.WithContext(ctx).Infof("Content hidden by reports: %s %d, case: %d", report.TargetType, report.TargetId, res.CaseId)
+	}
+	return nil
+}
+
+// checkReportTargetVisible 帖子按canView判断，评论需要处于正常状态且所属帖子可见
+func (uc *PostUsecase) checkReportTargetVisible(ctx context.Context, report *model.Report) error {
+	pid := report.TargetId
+	if report.TargetType == model.TargetComment {
+		var (
+			status int32
+			err    error
+		)
+		pid, status, err = uc.repo.GetCommentTarget(ctx, report.TargetId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errReportTarget
+			}
+			return err
+		}
+		if status != model.StatusNormal {
+			return errReportTarget
+		}
+	}
+	if _, err := uc.GetPostById(ctx, pid); err != nil {
+		if errors.Is(err, errPostNotExisted) || errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return errReportTarget
+		}
+		return err
+	}
+	return nil
+}
+
+// ListReportCases 版主按状态查看举报单
+func (uc *PostUsecase) ListReportCases(ctx context.Context, status int32, page, pageSize int64) ([]*model.ReportCase, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	cases, err := uc.repo.ListReportCases(ctx, status, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListReportCases/ListReportCases failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return cases, nil
+}
+
+// ListReports 版主查看举报单中的每条举报
+func (uc *PostUsecase) ListReports(ctx context.Context, caseId, page, pageSize int64) ([]*model.Report, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	reports, err := uc.repo.ListReports(ctx, caseId, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListReports/ListReports failed",
+			"err", err,
+			"case_id", caseId,
+		)
+		return nil, err
+	}
+	return reports, nil
+}
+
+// ResolveReport 举报成立，内容保持或改为隐藏
+func (uc *PostUsecase) ResolveReport(ctx context.Context, caseId int64, outcome string) (*model.ReportCase, error) {
+	return uc.handleReportCase(ctx, caseId, model.ReportCaseResolved, outcome)
+}
+
+// DismissReport 驳回举报，被自动隐藏的内容恢复可见
+func (uc *PostUsecase) DismissReport(ctx context.Context, caseId int64, outcome string) (*model.ReportCase, error) {
+	return uc.handleReportCase(ctx, caseId, model.ReportCaseDismissed, outcome)
+}
+
+func (uc *PostUsecase) handleReportCase(ctx context.Context, caseId int64, status int32, outcome string) (*model.ReportCase, error) {
+	if err := uc.checkModerator(ctx); err != nil {
+		return nil, err
+	}
+	handler, err := GetUidFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Handling report case: %d to status: %d by moderator: %d", caseId, status, handler)
+	reportCase, err := uc.repo.HandleReportCase(ctx, caseId, status, handler, strings.TrimSpace(outcome))
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "handleReportCase/HandleReportCase failed",
+			"err", err,
+			"case_id", caseId,
+		)
+		return nil, err
+	}
+	if reportCase == nil {
+		return nil, errReportCaseClosed
+	}
+	return reportCase, nil
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"post-service/third_party/linediff"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	errRevisionNotExisted = errors.New("revision not existed")
+	errNotModerator       = errors.New("permission denied: moderator only")
+)
+
+// ListRevisions 按版本号倒序列出帖子的编辑历史，不包含正文
+func (uc *PostUsecase) ListRevisions(ctx context.Context, pid, page, pageSize int64) ([]*model.Revision, error) {
+	if err := uc.checkRevisionVisible(ctx, pid); err != nil {
+		return nil, err
+	}
+	revisions, err := uc.repo.ListRevisions(ctx, pid, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListRevisions/ListRevisions failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (uc *PostUsecase) GetRevision(ctx context.Context, pid int64, rev int32) (*model.Revision, error) {
+	if err := uc.checkRevisionVisible(ctx, pid); err != nil {
+		return nil, err
+	}
+	return uc.getRevision(ctx, pid, rev)
+}
+
+func (uc *PostUsecase) getRevision(ctx context.Context, pid int64, rev int32) (*model.Revision, error) {
+	revision, err := uc.repo.GetRevision(ctx, pid, rev)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "getRevision/GetRevision failed",
+			"err", err,
+			"pid", pid,
+			"rev", rev,
+		)
+		return nil, err
+	}
+	if revision == nil {
+		return nil, errRevisionNotExisted
+	}
+	return revision, nil
+}
+
+// DiffRevisions 逐行比较两个版本的正文，fromRev可以大于toRev
+func (uc *PostUsecase) DiffRevisions(ctx context.Context, pid int64, fromRev, toRev int32) (*model.RevisionDiff, error) {
+	if err := uc.checkRevisionVisible(ctx, pid); err != nil {
+		return nil, err
+	}
+	from, err := uc.getRevision(ctx, pid, fromRev)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.getRevision(ctx, pid, toRev)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RevisionDiff{
+		From:  from,
+		To:    to,
+		Lines: linediff.Diff(from.Content, to.Content),
+	}, nil
+}
+
+// RestoreRevision 帖子所在版块的版主将帖子的标题、正文与标签恢复为某个历史版本，恢复本身也会记录为一个新版本
+func (uc *PostUsecase) RestoreRevision(ctx context.Context, pid int64, rev int32) (*model.Post, error) {
+	post, err := uc.repo.GetPostById(ctx, pid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errPostNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "RestoreRevision/GetPostById failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	if err := uc.checkBoardModerator(ctx, post.BoardId); err != nil {
+		return nil, err
+	}
+	revision, err := uc.getRevision(ctx, pid, rev)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("Restoring post: %d to revision: %d", pid, rev)
+	return uc.UpdatePost(ctx, &model.UpdatePostParam{
+		Pid:     pid,
+		Title:   &revision.Title,
+		Content: &revision.Content,
+		Tags:    revision.Tags,
+		Version: post.Version,
+	})
+}
+
+// checkRevisionVisible 编辑历史与帖子本身的可见范围一致，不可见时按帖子不存在处理
+func (uc *PostUsecase) checkRevisionVisible(ctx context.Context, pid int64) error {
+	post, err := uc.repo.GetPostById(ctx, pid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errPostNotExisted
+		}
+		uc.log.Errorw(
+			"[biz]", "checkRevisionVisible/GetPostById failed",
+			"err", err,
+			"pid", pid,
+		)
+		return err
+	}
+	ok, err := uc.canView(ctx, post)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errPostNotExisted
+	}
+	return nil
+}
+
+// checkModerator 当前用户不是版主时返回errNotModerator
+func (uc *PostUsecase) checkModerator(ctx context.Context) error {
+	uid, err := GetUidFromCtx(ctx)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "checkModerator/GetUidFromCtx failed",
+			"err", err,
+		)
+		return err
+	}
+	ok, err := uc.repo.IsModerator(ctx, uid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "checkModerator/IsModerator failed",
+			"err", err,
+			"uid", uid,
+		)
+		return err
+	}
+	if !ok {
+		return errNotModerator
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package biz
+
+import (
+	"context"
+	"post-service/internal/model"
+	"strings"
+)
+
+// SearchPosts 在标题、正文、作者与标签中搜索，结果按文本相关度与帖子热度综合排序
+func (uc *PostUsecase) SearchPosts(ctx context.Context, param *model.SearchPostsParam) ([]*model.SearchHit, error) {
+	param.Query = strings.TrimSpace(param.Query)
+	if param.Query == "" {
+		return nil, errInvalideParam
+	}
+	if param.Since != nil && param.Until != nil && !param.Since.Before(*param.Until) {
+		return nil, errInvalideParam
+	}
+	if param.Tag != "" {
+		tag, err := uc.resolveTag(ctx, param.Tag)
+		if err != nil {
+			return nil, err
+		}
+		param.Tag = tag
+	}
+
+	uc.log.WithContext(ctx).Infof("Searching posts with query: %s, page: %d, pageSize: %d", param.Query, param.Page, param.PageSize)
+	hits, err := uc.repo.SearchPosts(ctx, param)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "SearchPosts/SearchPosts failed",
+			"err", err,
+			"query", param.Query,
+		)
+		return nil, err
+	}
+	return hits, nil
+}
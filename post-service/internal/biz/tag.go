@@ -0,0 +1,191 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	DefaultTag     = "default"
+	maxTagsPerPost = 5
+	maxTagLen      = 32 // 按字符计算
+
+	trendingMaxDays  = 7
+	tagScoreOnCreate = 3 // 发帖对标签热度的贡献，点赞为1
+	tagScoreOnLike   = 1
+)
+
+var (
+	errTooManyTags = errors.New("too many tags")
+	errTagTooLong  = errors.New("tag is too long")
+)
+
+// normalizeTags 统一标签格式并替换别名：去除首尾空白与#前缀，转为小写，内部空白替换为-
+// 去重后数量不能超过maxTagsPerPost，为空时使用默认标签
+func (uc *PostUsecase) normalizeTags(ctx context.Context, tags []string) ([]string, error) {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		name := normalizeTag(tag)
+		if name == "" {
+			continue
+		}
+		if utf8.RuneCountInString(name) > maxTagLen {
+			return nil, errTagTooLong
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return []string{DefaultTag}, nil
+	}
+
+	aliases, err := uc.repo.ResolveTagAliases(ctx, names)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "normalizeTags/ResolveTagAliases failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(names))
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		if canonical, ok := aliases[name]; ok {
+			name = canonical
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		res = append(res, name)
+	}
+	if len(res) > maxTagsPerPost {
+		return nil, errTooManyTags
+	}
+	return res, nil
+}
+
+func normalizeTag(tag string) string {
+	tag = strings.TrimLeft(strings.TrimSpace(tag), "#")
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	return strings.Join(strings.FieldsFunc(tag, unicode.IsSpace), "-")
+}
+
+// resolveTag 将查询用的标签转换为目录中的标准名
+func (uc *PostUsecase) resolveTag(ctx context.Context, tag string) (string, error) {
+	name := normalizeTag(tag)
+	if name == "" {
+		return "", errInvalideParam
+	}
+	aliases, err := uc.repo.ResolveTagAliases(ctx, []string{name})
+	if err != nil {
+		return "", err
+	}
+	if canonical, ok := aliases[name]; ok {
+		return canonical, nil
+	}
+	return name, nil
+}
+
+// diffTags 返回新增与移除的标签
+func diffTags(oldTags, newTags []string) (added, removed []string) {
+	oldSet := make(map[string]struct{}, len(oldTags))
+	for _, tag := range oldTags {
+		oldSet[tag] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(newTags))
+	for _, tag := range newTags {
+		newSet[tag] = struct{}{}
+		if _, ok := oldSet[tag]; !ok {
+			added = append(added, tag)
+		}
+	}
+	for _, tag := range oldTags {
+		if _, ok := newSet[tag]; !ok {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
+
+// updateTagCounts 更新标签目录中的帖子数，失败只记录日志
+func (uc *PostUsecase) updateTagCounts(ctx context.Context, added, removed []string) {
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	if err := uc.repo.UpdateTagCounts(ctx, added, removed); err != nil {
+		uc.log.Errorw(
+			"[biz]", "updateTagCounts/UpdateTagCounts failed",
+			"err", err,
+			"added", added,
+			"removed", removed,
+		)
+	}
+}
+
+// incrTagTrend 累加当天的标签热度，失败只记录日志
+func (uc *PostUsecase) incrTagTrend(ctx context.Context, tags []string, score float64) {
+	if len(tags) == 0 {
+		return
+	}
+	if err := uc.repo.IncrTagTrend(ctx, time.Now(), tags, score); err != nil {
+		uc.log.Errorw(
+			"[biz]", "incrTagTrend/IncrTagTrend failed",
+			"err", err,
+			"tags", tags,
+		)
+	}
+}
+
+func (uc *PostUsecase) ListPostsByTag(ctx context.Context, tag string, page, pageSize int64) ([]*model.PostPreview, error) {
+	name, err := uc.resolveTag(ctx, tag)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := uc.repo.ListPostPreviewByTag(ctx, name, page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListPostsByTag/ListPostPreviewByTag failed",
+			"err", err,
+			"tag", name,
+		)
+		return nil, err
+	}
+	if page == 0 {
+		posts = uc.withPinned(ctx, model.PinScopeTag, name, posts)
+	}
+	return posts, nil
+}
+
+// ListTags 按帖子数倒序列出标签，prefix非空时只返回以其开头的标签
+func (uc *PostUsecase) ListTags(ctx context.Context, prefix string, page, pageSize int64) ([]*model.Tag, error) {
+	tags, err := uc.repo.ListTags(ctx, normalizeTag(prefix), page, pageSize)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListTags/ListTags failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ListTrendingTags 汇总最近days天的标签热度，越早的热度权重越低
+func (uc *PostUsecase) ListTrendingTags(ctx context.Context, days, limit int64) ([]*model.Tag, error) {
+	if days <= 0 || days > trendingMaxDays {
+		return nil, errInvalideParam
+	}
+	tags, err := uc.repo.ListTrendingTags(ctx, time.Now(), days, limit)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListTrendingTags/ListTrendingTags failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return tags, nil
+}
@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"post-service/internal/model"
+	"time"
+)
+
+// viewDedupeWindow 同一访客在该时间段内多次浏览同一帖子只计一次
+const viewDedupeWindow = 30 * time.Minute
+
+// GetPostDetail 获取帖子详情并记录浏览，浏览数先累计在redis中，由定时任务写入pg
+func (uc *PostUsecase) GetPostDetail(ctx context.Context, pid int64, ip string) (*model.Post, error) {
+	post, err := uc.GetPostById(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if uc.recordView(ctx, pid, ip) {
+		post.View++
+	}
+	return post, nil
+}
+
+// recordView 已登录用户按uid去重，否则按ip去重，记录失败不影响读取帖子
+func (uc *PostUsecase) recordView(ctx context.Context, pid int64, ip string) bool {
+	var visitor string
+	if uid, err := GetUidFromCtx(ctx); err == nil {
+		visitor = fmt.Sprintf("u:%d", uid)
+	} else if ip != "" {
+		visitor = "ip:" + ip
+	} else {
+		return false
+	}
+	ok, err := uc.repo.RecordView(ctx, pid, visitor, viewDedupeWindow)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "GetPostDetail/RecordView failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false
+	}
+	return ok
+}
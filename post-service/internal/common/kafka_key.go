@@ -6,4 +6,7 @@ var (
 
 const (
 	TopicPostCacheDel = "post.info"
+	TopicPostLike     = "post.like" // 点赞事件，key为pid，保证同一帖子的事件有序
+
+	GroupPostLike = "post-service.like"
 )
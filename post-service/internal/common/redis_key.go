@@ -4,9 +4,29 @@ const (
 	RKeyPostPrefix = "post:post_info:"         //post服务，post_info表
 	RKeyPostList   = "post:post_list:%s:%d:%d" // post服务，post列表，列表类型，页数，页大小
 	RKeyExpTime    = "post:post_info:expire"   // 用于实现过期时间抖动
-	RKeyPostLike   = "post:post_like:%v"       //记录每个post的点赞情况，存储pid与多个uid
+	RKeyPostLike   = "post:post_like:%v"       //记录每个post的点赞情况，存储pid与多个uid，是post_like表的缓存
 
-	RKeyPostHotRank = "post:post_rank:hot"
+	RKeyPostLikeMigrated = "post:post_like_migrated" // redis集合中的点赞已导入post_like
 
-	RKeyPostLock = "post:post_lock:%v" // post服务，post锁，key为post的唯一标识
+	RKeyPostViewDedupe  = "post:post_view:%d:%d"   // 浏览去重的HyperLogLog，pid，时间窗口序号
+	RKeyPostViewPending = "post:post_view_pending" // 尚未写入pg的浏览数增量，field为pid
+	RKeyPostViewFlush   = "post:post_view_flush"   // 正在写入pg的浏览数增量
+	RKeyPostViewBatch   = "post:post_view_batch"   // 正在写入pg的浏览数增量的批次号
+
+	RKeyPostHotRank     = "post:post_rank:hot"
+	RKeyBoardHotRank    = "post:post_rank:hot:board:%d" // 每个版块的热度排行榜，版块id
+	RKeyPostLeaderboard = "post:post_rank:top:%s"       // 按加权互动数排序的榜单，day/week/all
+	RKeyTagTrend        = "post:tag_trend:%s"           // 每天的标签热度，日期格式为20060102
+	RKeyTagTrendUnion   = "post:tag_trend_union:%d"     // 按衰减权重合并后的标签热度，天数
+
+	RKeyJobLease = "post:job_lease:%s" // 定时任务的租约，任务名
+	RKeyPostLock = "post:post_lock:%d" // 编辑帖子的锁，pid
+
+	RKeyCommentList = "post:comment_list:%d:%s" // 评论第一页缓存，pid，排序方式
+	RKeyCommentLike = "post:comment_like:%v"    // 记录每条评论的点赞情况，存储cid与多个uid
+	RKeyCommentRank = "post:comment_rank:%d"    // 每个帖子一级评论的热度排行，按点赞数与发布时间计算
+
+	RKeyCommentRankEmpty = "post:comment_rank_empty:%d" // 帖子没有一级评论的标记，避免每次请求都从pg重建排行
+
+	RKeyUserSession = "user:session:%s" // user服务维护的有效会话，用于校验jwt是否被吊销
 )
@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const boardColumns = `id, name, slug, description, post_permission,
+	array(select m.uid from board_moderator m where m.board_id = board.id order by m.create_time, m.uid) as moderators,
+	create_time, update_time`
+
+// CreateBoard slug已存在时返回nil
+func (repo *PostRepo) CreateBoard(ctx context.Context, board *model.Board) (*model.Board, error) {
+	sqlStr := `
+	insert into board(name, slug, description, post_permission)
+	values ($1, $2, $3, $4)
+	on conflict (slug) do nothing
+	returning ` + boardColumns
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, board.Name, board.Slug, board.Description, board.PostPermission)
+		if err != nil {
+			return nil, err
+		}
+		created, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Board])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Board)(nil), nil
+		}
+		return created, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "CreateBoard/Query failed",
+			"err", err,
+			"slug", board.Slug,
+		)
+		return nil, err
+	}
+	return res.(*model.Board), nil
+}
+
+// UpdateBoard 为nil的字段保持不变，版块不存在时返回nil
+func (repo *PostRepo) UpdateBoard(ctx context.Context, param *model.UpdateBoardParam) (*model.Board, error) {
+	sqlStr := `
+	update board
+	set name = coalesce($2, name), description = coalesce($3, description),
+		post_permission = coalesce($4, post_permission), update_time = current_timestamp
+	where id = $1
+	returning ` + boardColumns
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, param.Id, param.Name, param.Description, param.PostPermission)
+		if err != nil {
+			return nil, err
+		}
+		board, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Board])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Board)(nil), nil
+		}
+		return board, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "UpdateBoard/Query failed",
+			"err", err,
+			"board_id", param.Id,
+		)
+		return nil, err
+	}
+	return res.(*model.Board), nil
+}
+
+// GetBoard 按id或slug查询版块，id为0时按slug查询，版块不存在时返回nil
+func (repo *PostRepo) GetBoard(ctx context.Context, id int64, slug string) (*model.Board, error) {
+	sqlStr := `select ` + boardColumns + ` from board where id = $1`
+	arg := any(id)
+	if id == 0 {
+		sqlStr = `select ` + boardColumns + ` from board where slug = $1`
+		arg = slug
+	}
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, arg)
+		if err != nil {
+			return nil, err
+		}
+		board, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Board])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Board)(nil), nil
+		}
+		return board, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "GetBoard/Query failed",
+			"err", err,
+			"board_id", id,
+			"slug", slug,
+		)
+		return nil, err
+	}
+	return res.(*model.Board), nil
+}
+
+// ListBoards 按创建顺序列出所有版块
+func (repo *PostRepo) ListBoards(ctx context.Context) ([]*model.Board, error) {
+	sqlStr := `select ` + boardColumns + ` from board order by id`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.Board])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListBoards/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.Board), nil
+}
+
+// AddBoardModerator 已经是版块的版主时不做修改
+func (repo *PostRepo) AddBoardModerator(ctx context.Context, boardId, uid int64) error {
+	_, err := pgBreaker.Execute(func() (interface{}, error) {
+		return repo.data.PgxCli.Exec(ctx, `
+		insert into board_moderator(board_id, uid) values ($1, $2)
+		on conflict (board_id, uid) do nothing`, boardId, uid)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "AddBoardModerator/Exec failed",
+			"err", err,
+			"board_id", boardId,
+			"uid", uid,
+		)
+		return err
+	}
+	return nil
+}
+
+// RemoveBoardModerator 返回false表示该用户不是版块的版主
+func (repo *PostRepo) RemoveBoardModerator(ctx context.Context, boardId, uid int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, `
+		delete from board_moderator where board_id = $1 and uid = $2`, boardId, uid)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RemoveBoardModerator/Exec failed",
+			"err", err,
+			"board_id", boardId,
+			"uid", uid,
+		)
+		return false, err
+	}
+	return res.(int64) != 0, nil
+}
+
+// IsBoardModerator 全站版主可以管理所有版块，版块的版主只能管理自己的版块
+func (repo *PostRepo) IsBoardModerator(ctx context.Context, uid, boardId int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		var ok bool
+		err := repo.data.PgxCli.QueryRow(ctx, `
+		select exists (select 1 from post_moderator where uid = $1)
+			or exists (select 1 from board_moderator where uid = $1 and board_id = $2)`, uid, boardId).Scan(&ok)
+		return ok, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "IsBoardModerator/QueryRow failed",
+			"err", err,
+			"uid", uid,
+			"board_id", boardId,
+		)
+		return false, err
+	}
+	return res.(bool), nil
+}
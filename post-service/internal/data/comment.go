@@ -0,0 +1,524 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"post-service/internal/biz"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	commentCacheSize = 50 // 第一页缓存的评论条数，不小于接口允许的最大page_size
+	commentCacheTTL  = 5 * time.Minute
+	commentRankTTL   = 24 * time.Hour
+	commentEmptyTTL  = time.Minute
+	commentHotUnit   = 3600 // 一个赞相当于晚发布一小时
+
+	commentColumns = `id, cid, pid, create_time, uid, author, content, root_id, reply_to_uid, "like", reply_count, status`
+)
+
+// 排行存在时才写入，排行不存在时由读取方从pg重建，避免只含部分评论的排行
+var (
+	zaddIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0`)
+	zincrIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('ZINCRBY', KEYS[1], ARGV[1], ARGV[2])
+end
+return 0`)
+)
+
+// CommentRepo 评论与帖子共用数据源，复用帖子缓存的删除逻辑
+type CommentRepo struct {
+	*PostRepo
+}
+
+func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
+	return &CommentRepo{
+		PostRepo: &PostRepo{
+			data: data,
+			log:  log.NewHelper(logger),
+		},
+	}
+}
+
+// CreateComment 在一个事务中写入评论并更新帖子评论数与一级评论回复数
+func (repo *CommentRepo) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		sqlStr := `
+		insert into comment_info(cid, pid, uid, author, content, root_id, reply_to_uid)
+		values($1, $2, $3, $4, $5, $6, $7)
+		returning ` + commentColumns
+		replyComment := new(model.Comment)
+		if err := tx.QueryRow(ctx, sqlStr,
+			comment.Cid, comment.Pid, comment.Uid, comment.Author,
+			comment.Content, comment.RootId, comment.ReplyToUid,
+		).Scan(replyComment.ScanArgs()...); err != nil {
+			repo.log.Errorw(
+				"[repo]", "CreateComment/QueryRow failed",
+				"err", err,
+				"pid", comment.Pid,
+			)
+			return nil, err
+		}
+		if comment.RootId != 0 {
+			if _, err := tx.Exec(ctx, `
+			update comment_info set reply_count = reply_count + 1
+			where cid = $1 and is_del = 0`, comment.RootId); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := tx.Exec(ctx, `
+		update post_info set comment_count = comment_count + 1
+		where pid = $1 and is_del = 0`, comment.Pid); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return replyComment, nil
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "CreateComment/Execute failed",
+			"err", err,
+		)
+		return nil, err
+	}
+
+	replyComment := res.(*model.Comment)
+	if replyComment.RootId == 0 {
+		key := GetCommentRankKey(replyComment.Pid)
+		score := commentHotScore(replyComment.Like, replyComment.CreateTime)
+		if err := zaddIfExistsScript.Run(ctx, repo.data.Rcli, []string{key}, score, replyComment.Cid).Err(); err != nil {
+			repo.log.Errorw(
+				"[repo]", "CreateComment/zaddIfExists failed",
+				"err", err,
+				"cid", replyComment.Cid,
+			)
+		}
+		// 删除空排行标记，下次读取时从pg重建
+		if err := repo.data.Rcli.Del(ctx, GetCommentRankEmptyKey(replyComment.Pid)).Err(); err != nil {
+			repo.log.Errorw(
+				"[repo]", "CreateComment/Del failed",
+				"err", err,
+				"cid", replyComment.Cid,
+			)
+		}
+	}
+	repo.delCommentCache(ctx, comment.Pid)
+	return replyComment, nil
+}
+
+// DeleteComment 逻辑删除评论，删除一级评论时其下的回复一并删除
+func (repo *CommentRepo) DeleteComment(ctx context.Context, comment *model.Comment) error {
+	_, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		sqlStr := `
+		update comment_info set is_del = null
+		where cid = $1 and is_del = 0`
+		if comment.RootId == 0 {
+			sqlStr = `
+			update comment_info set is_del = null
+			where (cid = $1 or root_id = $1) and is_del = 0`
+		}
+		tag, err := tx.Exec(ctx, sqlStr, comment.Cid)
+		if err != nil {
+			repo.log.Errorw(
+				"[repo]", "DeleteComment/Exec failed",
+				"err", err,
+				"cid", comment.Cid,
+			)
+			return nil, err
+		}
+		n := tag.RowsAffected()
+		if n == 0 {
+			// 已被删除
+			return nil, nil
+		}
+		if comment.RootId != 0 {
+			if _, err := tx.Exec(ctx, `
+			update comment_info set reply_count = greatest(reply_count - 1, 0)
+			where cid = $1 and is_del = 0`, comment.RootId); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := tx.Exec(ctx, `
+		update post_info set comment_count = greatest(comment_count - $1, 0)
+		where pid = $2 and is_del = 0`, n, comment.Pid); err != nil {
+			return nil, err
+		}
+		return nil, tx.Commit(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	if comment.RootId == 0 {
+		if err := repo.data.Rcli.ZRem(ctx, GetCommentRankKey(comment.Pid), comment.Cid).Err(); err != nil {
+			repo.log.Errorw(
+				"[repo]", "DeleteComment/ZRem failed",
+				"err", err,
+				"cid", comment.Cid,
+			)
+		}
+	}
+	repo.delCommentCache(ctx, comment.Pid)
+	return nil
+}
+
+func (repo *CommentRepo) GetCommentByCid(ctx context.Context, cid int64) (*model.Comment, error) {
+	sqlStr := `
+	select ` + commentColumns + `
+	from comment_info where cid = $1 and is_del = 0`
+	comment := new(model.Comment)
+	if err := repo.data.PgxCli.QueryRow(ctx, sqlStr, cid).Scan(comment.ScanArgs()...); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// ListComments 分页获取一级评论，第一页走缓存
+func (repo *CommentRepo) ListComments(ctx context.Context, pid, page, pageSize int64, order string) ([]*model.Comment, error) {
+	orderBy := "create_time desc, id desc"
+	if order == biz.StrLike {
+		orderBy = `"like" desc, create_time desc, id desc`
+	}
+	sqlStr := `
+	select ` + commentColumns + `
+	from comment_info
+	where pid = $1 and root_id = 0 and is_del = 0 and status = 1
+	order by ` + orderBy + `
+	limit $2 offset $3`
+
+	if page != 0 || pageSize > commentCacheSize {
+		return repo.queryComments(ctx, sqlStr, pid, pageSize, page*pageSize)
+	}
+
+	key := GetCommentListKey(pid, order)
+	comments, err := repo.getCommentListFC(ctx, key)
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListComments/getCommentListFC failed",
+			"err", err,
+			"key", key,
+		)
+	}
+	if comments == nil {
+		// 缓存未命中或redis出错，同一个key只回源一次
+		res, err, _ := sfg.Do(key, func() (interface{}, error) {
+			comments, err := repo.queryComments(ctx, sqlStr, pid, commentCacheSize, 0)
+			if err != nil {
+				return nil, err
+			}
+			if err := repo.setCommentListFC(ctx, key, comments); err != nil {
+				repo.log.Errorw(
+					"[repo]", "ListComments/setCommentListFC failed",
+					"err", err,
+					"key", key,
+				)
+			}
+			return comments, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		comments = res.([]*model.Comment)
+	}
+	if int64(len(comments)) > pageSize {
+		comments = comments[:pageSize]
+	}
+	return comments, nil
+}
+
+func (repo *CommentRepo) ListReplies(ctx context.Context, rootId, page, pageSize int64) ([]*model.Comment, error) {
+	sqlStr := `
+	select ` + commentColumns + `
+	from comment_info
+	where root_id = $1 and is_del = 0 and status = 1
+	order by create_time, id
+	limit $2 offset $3`
+	return repo.queryComments(ctx, sqlStr, rootId, pageSize, page*pageSize)
+}
+
+// ListCommentsByHot 从redis热评排行中分页，再从pg批量读取评论内容
+func (repo *CommentRepo) ListCommentsByHot(ctx context.Context, pid, page, pageSize int64) ([]*model.Comment, error) {
+	start := page * pageSize
+	end := (page+1)*pageSize - 1
+	members, ok, err := repo.rangeCommentRank(ctx, pid, start, end)
+	if err == nil && !ok {
+		// 排行不存在时从pg重建
+		if err = repo.rebuildCommentRank(ctx, pid); err == nil {
+			members, _, err = repo.rangeCommentRank(ctx, pid, start, end)
+		}
+	}
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListCommentsByHot/rangeCommentRank failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	if len(members) == 0 {
+		return []*model.Comment{}, nil
+	}
+
+	cids := make([]int64, 0, len(members))
+	for _, member := range members {
+		cid, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			repo.log.Errorw(
+				"[repo]", "ListCommentsByHot/ParseInt failed",
+				"err", err,
+				"member", member,
+			)
+			continue
+		}
+		cids = append(cids, cid)
+	}
+	sqlStr := `
+	select ` + commentColumns + `
+	from comment_info
+	where cid = any($1) and is_del = 0 and status = 1`
+	found, err := repo.queryComments(ctx, sqlStr, cids)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[int64]*model.Comment, len(found))
+	for _, comment := range found {
+		m[comment.Cid] = comment
+	}
+	// 按排行中的顺序返回
+	comments := make([]*model.Comment, 0, len(cids))
+	for _, cid := range cids {
+		if comment, ok := m[cid]; ok {
+			comments = append(comments, comment)
+		}
+	}
+	return comments, nil
+}
+
+// ListCommentsByHotFallback redis不可用时直接在pg中按热度公式排序
+func (repo *CommentRepo) ListCommentsByHotFallback(ctx context.Context, pid, page, pageSize int64) ([]*model.Comment, error) {
+	sqlStr := fmt.Sprintf(`
+	select `+commentColumns+`
+	from comment_info
+	where pid = $1 and root_id = 0 and is_del = 0 and status = 1
+	order by "like" + extract(epoch from create_time) / %d desc, id desc
+	limit $2 offset $3`, commentHotUnit)
+	return repo.queryComments(ctx, sqlStr, pid, pageSize, page*pageSize)
+}
+
+// UpdateCommentLike 原子地更新点赞数，并同步热评排行与评论列表缓存
+func (repo *CommentRepo) UpdateCommentLike(ctx context.Context, comment *model.Comment, delta int64) (*model.Comment, error) {
+	sqlStr := `
+	update comment_info set "like" = greatest("like" + $1, 0)
+	where cid = $2 and is_del = 0
+	returning ` + commentColumns
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		replyComment := new(model.Comment)
+		if err := repo.data.PgxCli.QueryRow(ctx, sqlStr, delta, comment.Cid).Scan(replyComment.ScanArgs()...); err != nil {
+			repo.log.Errorw(
+				"[repo]", "UpdateCommentLike/QueryRow failed",
+				"err", err,
+				"cid", comment.Cid,
+			)
+			return nil, err
+		}
+		return replyComment, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replyComment := res.(*model.Comment)
+	if replyComment.RootId == 0 {
+		key := GetCommentRankKey(replyComment.Pid)
+		if err := zincrIfExistsScript.Run(ctx, repo.data.Rcli, []string{key}, delta, replyComment.Cid).Err(); err != nil {
+			repo.log.Errorw(
+				"[repo]", "UpdateCommentLike/zincrIfExists failed",
+				"err", err,
+				"cid", replyComment.Cid,
+			)
+		}
+	}
+	repo.delCommentListFC(ctx, replyComment.Pid)
+	return replyComment, nil
+}
+
+// rangeCommentRank 分页读取热评排行，排行与空排行标记都不存在时ok为false
+func (repo *CommentRepo) rangeCommentRank(ctx context.Context, pid, start, end int64) ([]string, bool, error) {
+	key := GetCommentRankKey(pid)
+	var ok bool
+	res, err := redisBreaker.Execute(func() (interface{}, error) {
+		pipe := repo.data.Rcli.Pipeline()
+		existsCmd := pipe.Exists(ctx, key, GetCommentRankEmptyKey(pid))
+		rangeCmd := pipe.ZRevRange(ctx, key, start, end)
+		if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+			return nil, err
+		}
+		ok = existsCmd.Val() > 0
+		return rangeCmd.Val(), nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return res.([]string), ok, nil
+}
+
+// rebuildCommentRank 从pg读取帖子的全部一级评论重建热评排行
+// pg查询不放在redisBreaker中，避免pg故障触发redis熔断；没有一级评论时写入短期的空排行标记
+func (repo *CommentRepo) rebuildCommentRank(ctx context.Context, pid int64) error {
+	sqlStr := `
+	select cid, "like", create_time
+	from comment_info
+	where pid = $1 and root_id = 0 and is_del = 0 and status = 1`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pid)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (redis.Z, error) {
+			var (
+				cid        int64
+				like       int64
+				createTime time.Time
+			)
+			err := row.Scan(&cid, &like, &createTime)
+			return redis.Z{
+				Score:  commentHotScore(like, createTime),
+				Member: cid,
+			}, err
+		})
+	})
+	if err != nil {
+		return err
+	}
+	members := res.([]redis.Z)
+
+	_, err = redisBreaker.Execute(func() (interface{}, error) {
+		if len(members) == 0 {
+			return nil, repo.data.Rcli.Set(ctx, GetCommentRankEmptyKey(pid), 1, commentEmptyTTL).Err()
+		}
+		key := GetCommentRankKey(pid)
+		pipe := repo.data.Rcli.TxPipeline()
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, commentRankTTL)
+		_, err := pipe.Exec(ctx)
+		return nil, err
+	})
+	return err
+}
+
+func (repo *CommentRepo) queryComments(ctx context.Context, sqlStr string, args ...any) ([]*model.Comment, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.Comment, error) {
+			comment := new(model.Comment)
+			err := row.Scan(comment.ScanArgs()...)
+			return comment, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "queryComments/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.Comment), nil
+}
+
+// getCommentListFC 缓存未命中不会报错而是返回nil
+func (repo *CommentRepo) getCommentListFC(ctx context.Context, key string) ([]*model.Comment, error) {
+	listJson, err := repo.data.Rcli.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	comments := make([]*model.Comment, 0)
+	if err := json.Unmarshal(listJson, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (repo *CommentRepo) setCommentListFC(ctx context.Context, key string, comments []*model.Comment) error {
+	listJson, err := json.Marshal(comments)
+	if err != nil {
+		return err
+	}
+	return repo.data.Rcli.Set(ctx, key, listJson, commentCacheTTL).Err()
+}
+
+// delCommentListFC 删除评论第一页缓存
+func (repo *PostRepo) delCommentListFC(ctx context.Context, pid int64) {
+	if err := repo.data.Rcli.Del(ctx,
+		GetCommentListKey(pid, biz.StrTime),
+		GetCommentListKey(pid, biz.StrLike),
+	).Err(); err != nil {
+		repo.log.Errorw(
+			"[repo]", "delCommentListFC/Del failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+}
+
+// delCommentCache 评论变更后删除评论列表与帖子缓存，帖子缓存中包含评论数
+func (repo *CommentRepo) delCommentCache(ctx context.Context, pid int64) {
+	repo.delCommentListFC(ctx, pid)
+	if err := delCacheAfterWrite(ctx, repo.PostRepo, pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "delCommentCache/delCacheAfterWrite failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+}
+
+func GetCommentListKey(pid int64, order string) string {
+	return fmt.Sprintf(common.RKeyCommentList, pid, order)
+}
+
+func GetCommentRankKey(pid int64) string {
+	return fmt.Sprintf(common.RKeyCommentRank, pid)
+}
+
+func GetCommentRankEmptyKey(pid int64) string {
+	return fmt.Sprintf(common.RKeyCommentRankEmpty, pid)
+}
+
+// commentHotScore 热度 = 点赞数 + 发布时间(小时)，与ListCommentsByHotFallback中的公式一致
+func commentHotScore(like int64, createTime time.Time) float64 {
+	return float64(like) + float64(createTime.Unix())/commentHotUnit
+}
@@ -15,7 +15,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewPostRepo, NewPostRepoForJob)
+var ProviderSet = wire.NewSet(NewData, NewLocker, NewPostRepo, NewPostRepoForJob, NewCommentRepo, NewFavoriteRepo)
 
 // Data .
 type Data struct {
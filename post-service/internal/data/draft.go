@@ -0,0 +1,201 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"post-service/third_party/redislock"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lib/pq"
+)
+
+const draftColumns = `did, uid, title, content, tags, board_id, publish_at, create_time, update_time`
+
+// SaveDraft 保存草稿，Did为0时新建，返回nil表示草稿不存在或不属于该用户
+func (repo *PostRepo) SaveDraft(ctx context.Context, param *model.SaveDraftParam, newDid int64) (*model.Draft, error) {
+	sqlStr := `
+	insert into post_draft(did, uid, title, content, tags, publish_at, board_id)
+	values($1, $2, $3, $4, $5, $6, $7)
+	returning ` + draftColumns
+	args := []any{newDid, param.Uid, param.Title, param.Content, pq.Array(param.Tags), timeArg(param.PublishAt), param.BoardId}
+	if param.Did != 0 {
+		sqlStr = `
+		update post_draft
+		set title = $3, content = $4, tags = $5, publish_at = $6, board_id = $7, update_time = current_timestamp
+		where did = $1 and uid = $2
+		returning ` + draftColumns
+		args[0] = param.Did
+	}
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		draft, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Draft])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Draft)(nil), nil
+		}
+		return draft, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "SaveDraft/Query failed",
+			"err", err,
+			"did", param.Did,
+		)
+		return nil, err
+	}
+	return res.(*model.Draft), nil
+}
+
+// GetDraft 返回nil表示草稿不存在或不属于该用户
+func (repo *PostRepo) GetDraft(ctx context.Context, uid, did int64) (*model.Draft, error) {
+	sqlStr := `select ` + draftColumns + ` from post_draft where did = $1 and uid = $2`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, did, uid)
+		if err != nil {
+			return nil, err
+		}
+		draft, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Draft])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Draft)(nil), nil
+		}
+		return draft, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "GetDraft/Query failed",
+			"err", err,
+			"did", did,
+		)
+		return nil, err
+	}
+	return res.(*model.Draft), nil
+}
+
+// ListDrafts 按最后修改时间倒序列出用户的草稿，包括定时发布的草稿
+func (repo *PostRepo) ListDrafts(ctx context.Context, uid, page, pageSize int64) ([]*model.Draft, error) {
+	sqlStr := `
+	select ` + draftColumns + `
+	from post_draft
+	where uid = $1
+	order by update_time desc, did desc
+	limit $2 offset $3`
+	return repo.queryDrafts(ctx, "ListDrafts", sqlStr, uid, pageSize, page*pageSize)
+}
+
+// ListDueDrafts 列出定时发布时间已到的草稿，按发布时间先后排序
+func (repo *PostRepo) ListDueDrafts(ctx context.Context, now time.Time, limit int64) ([]*model.Draft, error) {
+	sqlStr := `
+	select ` + draftColumns + `
+	from post_draft
+	where publish_at is not null and publish_at <= $1
+	order by publish_at, did
+	limit $2`
+	return repo.queryDrafts(ctx, "ListDueDrafts", sqlStr, now.UTC(), limit)
+}
+
+func (repo *PostRepo) queryDrafts(ctx context.Context, name, sqlStr string, args ...any) ([]*model.Draft, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.Draft])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", name+"/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.Draft), nil
+}
+
+// DeleteDraft 返回false表示草稿不存在或不属于该用户
+func (repo *PostRepo) DeleteDraft(ctx context.Context, uid, did int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, `delete from post_draft where did = $1 and uid = $2`, did, uid)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "DeleteDraft/Exec failed",
+			"err", err,
+			"did", did,
+		)
+		return false, err
+	}
+	return res.(int64) != 0, nil
+}
+
+// PublishDraft 在一个事务中删除草稿并写入帖子，草稿已被发布或删除时返回pgx.ErrNoRows
+// 删除草稿时会锁住该行，多个实例或用户手动发布与定时任务同时发布时只有一个能成功
+// 定时任务的租约已被其他实例接手时返回redislock.ErrFenceStale
+func (repo *PostRepo) PublishDraft(ctx context.Context, did int64, post *model.CreatePostParam) (*model.Post, error) {
+	var stale bool
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		ok, err := checkFence(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			stale = true
+			return (*model.Post)(nil), nil
+		}
+		tag, err := tx.Exec(ctx, `delete from post_draft where did = $1 and uid = $2`, did, post.Uid)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return (*model.Post)(nil), nil
+		}
+		replyPost := new(model.Post)
+		args := append(post.ToArgs(), searchArgs(post.Title, post.Content, post.Author, post.Tags)...)
+		if err := tx.QueryRow(ctx, sqlInsertPost, args...).Scan(replyPost.ScanArgs()...); err != nil {
+			return nil, err
+		}
+		return replyPost, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "PublishDraft/Execute failed",
+			"err", err,
+			"did", did,
+		)
+		return nil, err
+	}
+	if stale {
+		return nil, redislock.ErrFenceStale
+	}
+	replyPost := res.(*model.Post)
+	if replyPost == nil {
+		return nil, pgx.ErrNoRows
+	}
+
+	// 帖子已经写入，加入排行榜失败时只记录日志，不影响发布结果
+	if err := repo.syncHotRank(ctx, replyPost); err != nil {
+		repo.log.Errorw(
+			"[repo]", "PublishDraft/syncHotRank failed",
+			"err", err,
+			"pid", replyPost.Pid,
+		)
+	}
+	if err := delCacheAfterWrite(ctx, repo, replyPost.Pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "PublishDraft/DelPostFC failed",
+			"err", err,
+			"pid", replyPost.Pid,
+		)
+	}
+	return replyPost, nil
+}
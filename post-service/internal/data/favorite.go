@@ -0,0 +1,262 @@
+package data
+
+import (
+	"context"
+	"post-service/internal/biz"
+	"post-service/internal/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5"
+)
+
+// FavoriteRepo 收藏与帖子共用数据源，复用帖子缓存的删除逻辑
+type FavoriteRepo struct {
+	*PostRepo
+}
+
+func NewFavoriteRepo(data *Data, logger log.Logger) biz.FavoriteRepo {
+	return &FavoriteRepo{
+		PostRepo: &PostRepo{
+			data: data,
+			log:  log.NewHelper(logger),
+		},
+	}
+}
+
+// AddFavorite 在一个事务中写入收藏并更新帖子收藏数，返回false表示已经收藏过
+func (repo *FavoriteRepo) AddFavorite(ctx context.Context, fav *model.Favorite) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		tag, err := tx.Exec(ctx, `
+		insert into favorite(uid, pid, collection_id) values($1, $2, $3)
+		on conflict (uid, pid) do nothing`, fav.Uid, fav.Pid, fav.CollectionId)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return false, nil
+		}
+		if _, err := tx.Exec(ctx, `
+		update post_info set favorite_count = favorite_count + 1
+		where pid = $1 and is_del = 0`, fav.Pid); err != nil {
+			return nil, err
+		}
+		return true, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "AddFavorite/Execute failed",
+			"err", err,
+			"pid", fav.Pid,
+		)
+		return false, err
+	}
+	if res.(bool) {
+		if err := delCacheAfterWrite(ctx, repo.PostRepo, fav.Pid); err != nil {
+			repo.log.Errorw(
+				"[repo]", "AddFavorite/DelPostFC failed",
+				"err", err,
+				"pid", fav.Pid,
+			)
+		}
+	}
+	return res.(bool), nil
+}
+
+// RemoveFavorite 在一个事务中删除收藏并更新帖子收藏数，返回false表示没有收藏过
+func (repo *FavoriteRepo) RemoveFavorite(ctx context.Context, uid, pid int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		tag, err := tx.Exec(ctx, `delete from favorite where uid = $1 and pid = $2`, uid, pid)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return false, nil
+		}
+		if _, err := tx.Exec(ctx, `
+		update post_info set favorite_count = greatest(favorite_count - 1, 0)
+		where pid = $1 and is_del = 0`, pid); err != nil {
+			return nil, err
+		}
+		return true, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RemoveFavorite/Execute failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	if res.(bool) {
+		if err := delCacheAfterWrite(ctx, repo.PostRepo, pid); err != nil {
+			repo.log.Errorw(
+				"[repo]", "RemoveFavorite/DelPostFC failed",
+				"err", err,
+				"pid", pid,
+			)
+		}
+	}
+	return res.(bool), nil
+}
+
+// ListFavorites 按收藏时间倒序列出收藏，collectionId为nil时列出全部收藏
+func (repo *FavoriteRepo) ListFavorites(ctx context.Context, uid int64, collectionId *int64, page, pageSize int64) ([]*model.Favorite, error) {
+	sqlStr := `
+	select uid, pid, collection_id, create_time
+	from favorite
+	where uid = $1
+	order by create_time desc, pid desc
+	limit $2 offset $3`
+	args := []any{uid, pageSize, page * pageSize}
+	if collectionId != nil {
+		sqlStr = `
+		select uid, pid, collection_id, create_time
+		from favorite
+		where uid = $1 and collection_id = $4
+		order by create_time desc, pid desc
+		limit $2 offset $3`
+		args = append(args, *collectionId)
+	}
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.Favorite])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListFavorites/Query failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return res.([]*model.Favorite), nil
+}
+
+// MoveFavorite 将收藏移动到另一个收藏夹，返回false表示没有收藏过
+func (repo *FavoriteRepo) MoveFavorite(ctx context.Context, uid, pid, collectionId int64) (bool, error) {
+	return repo.execAffected(ctx, "MoveFavorite", `
+	update favorite set collection_id = $3
+	where uid = $1 and pid = $2`, uid, pid, collectionId)
+}
+
+func (repo *FavoriteRepo) CreateCollection(ctx context.Context, collection *model.Collection) (*model.Collection, error) {
+	sqlStr := `
+	insert into favorite_collection(collection_id, uid, name)
+	values($1, $2, $3)
+	returning collection_id, uid, name, create_time`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		reply := new(model.Collection)
+		err := repo.data.PgxCli.QueryRow(ctx, sqlStr, collection.CollectionId, collection.Uid, collection.Name).
+			Scan(&reply.CollectionId, &reply.Uid, &reply.Name, &reply.CreateTime)
+		return reply, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "CreateCollection/QueryRow failed",
+			"err", err,
+			"uid", collection.Uid,
+		)
+		return nil, err
+	}
+	return res.(*model.Collection), nil
+}
+
+// RenameCollection 返回false表示收藏夹不存在或不属于该用户
+func (repo *FavoriteRepo) RenameCollection(ctx context.Context, uid, collectionId int64, name string) (bool, error) {
+	return repo.execAffected(ctx, "RenameCollection", `
+	update favorite_collection set name = $3
+	where uid = $1 and collection_id = $2`, uid, collectionId, name)
+}
+
+// DeleteCollection 删除收藏夹，其中的收藏移回未分类，返回false表示收藏夹不存在或不属于该用户
+func (repo *FavoriteRepo) DeleteCollection(ctx context.Context, uid, collectionId int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		tag, err := tx.Exec(ctx, `
+		delete from favorite_collection
+		where uid = $1 and collection_id = $2`, uid, collectionId)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return false, nil
+		}
+		if _, err := tx.Exec(ctx, `
+		update favorite set collection_id = 0
+		where uid = $1 and collection_id = $2`, uid, collectionId); err != nil {
+			return nil, err
+		}
+		return true, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "DeleteCollection/Execute failed",
+			"err", err,
+			"collectionId", collectionId,
+		)
+		return false, err
+	}
+	return res.(bool), nil
+}
+
+// ListCollections 按创建时间列出用户的收藏夹及其中的收藏数
+func (repo *FavoriteRepo) ListCollections(ctx context.Context, uid int64) ([]*model.Collection, error) {
+	sqlStr := `
+	select c.collection_id, c.uid, c.name, c.create_time, count(f.pid) as favorite_count
+	from favorite_collection c
+	left join favorite f on f.uid = c.uid and f.collection_id = c.collection_id
+	where c.uid = $1
+	group by c.collection_id, c.uid, c.name, c.create_time
+	order by c.create_time`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, uid)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.Collection])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListCollections/Query failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return res.([]*model.Collection), nil
+}
+
+func (repo *FavoriteRepo) execAffected(ctx context.Context, name, sqlStr string, args ...any) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, args...)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", name+"/Exec failed",
+			"err", err,
+		)
+		return false, err
+	}
+	return res.(int64) != 0, nil
+}
@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"post-service/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (repo *PostRepo) RecordFilterLog(ctx context.Context, entry *model.FilterLog) error {
+	_, err := pgBreaker.Execute(func() (interface{}, error) {
+		return repo.data.PgxCli.Exec(ctx, `
+		insert into content_filter_log(target_type, target_id, uid, action, categories, terms)
+		values ($1, $2, $3, $4, $5, $6)`,
+			entry.TargetType, entry.TargetId, entry.Uid, entry.Action, entry.Categories, entry.Terms)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RecordFilterLog/Exec failed",
+			"err", err,
+			"target_type", entry.TargetType,
+			"target_id", entry.TargetId,
+		)
+		return err
+	}
+	return nil
+}
+
+// ListFilterLogs 按时间倒序列出敏感词命中记录，targetType为空时不限类型，targetId为0时不限对象
+func (repo *PostRepo) ListFilterLogs(ctx context.Context, targetType string, targetId, page, pageSize int64) ([]*model.FilterLog, error) {
+	sqlStr := `
+	select id, target_type, target_id, uid, action, categories, terms, create_time
+	from content_filter_log
+	where ($1::text = '' or target_type = $1) and ($2::bigint = 0 or target_id = $2)
+	order by id desc
+	limit $3 offset $4`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, targetType, targetId, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.FilterLog])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListFilterLogs/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.FilterLog), nil
+}
@@ -0,0 +1,510 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
+)
+
+// PublishLikeEvent 发送点赞事件，以pid为key保证同一帖子的事件进入同一分区
+func (repo *PostRepo) PublishLikeEvent(ctx context.Context, event *model.LikeEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := repo.data.KafkaW.WriteMessages(ctx, kafka.Message{
+		Topic: common.TopicPostLike,
+		Key:   []byte(strconv.FormatInt(event.Pid, 10)),
+		Value: value,
+	}); err != nil {
+		repo.log.Errorw(
+			"[repo]", "PublishLikeEvent/WriteMessages failed",
+			"err", err,
+			"pid", event.Pid,
+		)
+		return err
+	}
+	return nil
+}
+
+// ApplyLikeEvents 在同一事务中记录事件id并累加点赞数，已经处理过的事件会被跳过
+// 返回点赞数发生变化的帖子，用于重新计算热度
+func (repo *PostRepo) ApplyLikeEvents(ctx context.Context, events []*model.LikeEvent) ([]*model.PostStat, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	eventIds := make([]int64, 0, len(events))
+	eventPids := make([]int64, 0, len(events))
+	deltaById := make(map[int64]*model.LikeEvent, len(events))
+	for _, event := range events {
+		eventIds = append(eventIds, event.EventId)
+		eventPids = append(eventPids, event.Pid)
+		deltaById[event.EventId] = event
+	}
+
+	insertSql := `
+	insert into like_event_applied(event_id, pid)
+	select * from unnest($1::bigint[], $2::bigint[])
+	on conflict (event_id) do nothing
+	returning event_id`
+	updateSql := `
+	update post_info
+	set "like" = greatest("like" + v.delta, 0)
+	from unnest($1::bigint[], $2::bigint[]) as v(pid, delta)
+	where post_info.pid = v.pid and post_info.is_del = 0
+	returning post_info.pid, post_info.create_time, post_info.view, post_info."like", post_info.comment_count`
+
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		rows, err := tx.Query(ctx, insertSql, eventIds, eventPids)
+		if err != nil {
+			return nil, err
+		}
+		applied, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return nil, err
+		}
+		if len(applied) == 0 {
+			return []*model.PostStat{}, tx.Commit(ctx)
+		}
+
+		// 同一帖子的多个事件合并为一次更新
+		deltas := make(map[int64]int64, len(applied))
+		for _, id := range applied {
+			event := deltaById[id]
+			deltas[event.Pid] += event.Delta
+		}
+		pids := make([]int64, 0, len(deltas))
+		values := make([]int64, 0, len(deltas))
+		for pid, delta := range deltas {
+			if delta == 0 {
+				continue
+			}
+			pids = append(pids, pid)
+			values = append(values, delta)
+		}
+		rows, err = tx.Query(ctx, updateSql, pids, values)
+		if err != nil {
+			return nil, err
+		}
+		stats, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.PostStat, error) {
+			stat := new(model.PostStat)
+			err := row.Scan(&stat.Pid, &stat.CreateTime, &stat.View, &stat.Like, &stat.CommentCount)
+			return stat, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return stats, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ApplyLikeEvents/Tx failed",
+			"err", err,
+			"count", len(events),
+		)
+		return nil, err
+	}
+
+	stats := res.([]*model.PostStat)
+	for _, stat := range stats {
+		if err := delCacheAfterWrite(ctx, repo, stat.Pid); err != nil {
+			repo.log.Errorw(
+				"[repo]", "ApplyLikeEvents/DelPostFC failed",
+				"err", err,
+				"pid", stat.Pid,
+			)
+		}
+	}
+	return stats, nil
+}
+
+// ListLikeCounts 按pid顺序分页读取post_info中的点赞数，并附带post_like中的实际点赞人数
+func (repo *PostRepo) ListLikeCounts(ctx context.Context, afterPid, limit int64) ([]*model.LikeCount, error) {
+	sqlStr := `
+	select p.pid, p."like", (select count(*) from post_like l where l.pid = p.pid)
+	from post_info p
+	where p.is_del = 0 and p.pid > $1
+	order by p.pid
+	limit $2`
+	return repo.queryLikeCounts(ctx, sqlStr, afterPid, limit)
+}
+
+// GetLikeCounts 读取指定帖子的点赞数，用于对账时的二次确认
+func (repo *PostRepo) GetLikeCounts(ctx context.Context, pids []int64) ([]*model.LikeCount, error) {
+	sqlStr := `
+	select p.pid, p."like", (select count(*) from post_like l where l.pid = p.pid)
+	from post_info p
+	where p.is_del = 0 and p.pid = any($1)`
+	return repo.queryLikeCounts(ctx, sqlStr, pids)
+}
+
+func (repo *PostRepo) queryLikeCounts(ctx context.Context, sqlStr string, args ...any) ([]*model.LikeCount, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.LikeCount, error) {
+			count := new(model.LikeCount)
+			err := row.Scan(&count.Pid, &count.PgLike, &count.Actual)
+			return count, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "queryLikeCounts/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.LikeCount), nil
+}
+
+// RepairLikeCount 以post_like为准修正点赞数，pg中的值与对账时读到的不一致时放弃修正
+func (repo *PostRepo) RepairLikeCount(ctx context.Context, count *model.LikeCount) (bool, error) {
+	sqlStr := `
+	update post_info
+	set "like" = $2
+	where pid = $1 and "like" = $3 and is_del = 0`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, count.Pid, count.Actual, count.PgLike)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RepairLikeCount/Exec failed",
+			"err", err,
+			"pid", count.Pid,
+		)
+		return false, err
+	}
+	if res.(int64) == 0 {
+		return false, nil
+	}
+	if err := delCacheAfterWrite(ctx, repo, count.Pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "RepairLikeCount/DelPostFC failed",
+			"err", err,
+			"pid", count.Pid,
+		)
+	}
+	return true, nil
+}
+
+// LikeEventLag 返回点赞事件的消费组在所有分区上尚未提交的消息数
+// 没有提交过offset的分区按分区中的全部消息计算
+func (repo *PostRepo) LikeEventLag(ctx context.Context) (int64, error) {
+	partitions, err := repo.data.KafkaConn.ReadPartitions(common.TopicPostLike)
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "LikeEventLag/ReadPartitions failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	ids := make([]int, 0, len(partitions))
+	requests := make([]kafka.OffsetRequest, 0, len(partitions))
+	for _, p := range partitions {
+		ids = append(ids, p.ID)
+		requests = append(requests, kafka.FirstOffsetOf(p.ID), kafka.LastOffsetOf(p.ID))
+	}
+
+	client := &kafka.Client{Addr: repo.data.KafkaW.Addr}
+	committed, err := client.OffsetFetch(ctx, &kafka.OffsetFetchRequest{
+		GroupID: common.GroupPostLike,
+		Topics:  map[string][]int{common.TopicPostLike: ids},
+	})
+	if err == nil {
+		err = committed.Error
+	}
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "LikeEventLag/OffsetFetch failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	offsets, err := client.ListOffsets(ctx, &kafka.ListOffsetsRequest{
+		Topics: map[string][]kafka.OffsetRequest{common.TopicPostLike: requests},
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "LikeEventLag/ListOffsets failed",
+			"err", err,
+		)
+		return 0, err
+	}
+
+	commitBy := make(map[int]int64, len(ids))
+	for _, p := range committed.Topics[common.TopicPostLike] {
+		if p.Error != nil {
+			return 0, p.Error
+		}
+		commitBy[p.Partition] = p.CommittedOffset
+	}
+	var lag int64
+	for _, p := range offsets.Topics[common.TopicPostLike] {
+		if p.Error != nil {
+			return 0, p.Error
+		}
+		commit, ok := commitBy[p.Partition]
+		if !ok || commit < p.FirstOffset {
+			commit = p.FirstOffset
+		}
+		if p.LastOffset > commit {
+			lag += p.LastOffset - commit
+		}
+	}
+	return lag, nil
+}
+
+// CleanLikeEvents 删除before之前记录的事件id，事件在kafka中的保留时间应短于该时间
+func (repo *PostRepo) CleanLikeEvents(ctx context.Context, before time.Time) (int64, error) {
+	sqlStr := `delete from like_event_applied where create_time < $1`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, before.UTC())
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "CleanLikeEvents/Exec failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	return res.(int64), nil
+}
+
+// likeSetPlaceholder 点赞集合中的占位成员，保证没有点赞的帖子也能缓存，uid不会为0
+const (
+	likeSetPlaceholder = 0
+	likeSetTTL         = 24 * time.Hour
+)
+
+// 集合存在时才写入，避免在缓存未重建时写入部分数据
+var saddIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('SADD', KEYS[1], ARGV[1])
+end
+return -1`)
+
+// HasLikedPost 查询用户是否点赞过帖子，redis中的集合不存在时从post_like重建
+func (repo *PostRepo) HasLikedPost(ctx context.Context, pid, uid int64) (bool, error) {
+	key := fmt.Sprintf(common.RKeyPostLike, pid)
+	pipe := repo.data.Rcli.Pipeline()
+	existed := pipe.Exists(ctx, key)
+	isMember := pipe.SIsMember(ctx, key, uid)
+	if _, err := pipe.Exec(ctx); err != nil {
+		repo.log.Errorw(
+			"[repo]", "HasLikedPost/Exec failed",
+			"err", err,
+			"pid", pid,
+		)
+		return repo.hasLikedPostFromPG(ctx, pid, uid)
+	}
+	if existed.Val() == 1 {
+		return isMember.Val(), nil
+	}
+
+	// 缓存未命中，使用singleflight防止同一帖子的集合被并发重建
+	_, err, _ := sfg.Do(key, func() (interface{}, error) {
+		return nil, repo.rebuildLikeSet(ctx, pid)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "HasLikedPost/rebuildLikeSet failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+	return repo.hasLikedPostFromPG(ctx, pid, uid)
+}
+
+func (repo *PostRepo) hasLikedPostFromPG(ctx context.Context, pid, uid int64) (bool, error) {
+	sqlStr := `select exists(select 1 from post_like where pid = $1 and uid = $2)`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		var liked bool
+		err := repo.data.PgxCli.QueryRow(ctx, sqlStr, pid, uid).Scan(&liked)
+		return liked, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "hasLikedPostFromPG/QueryRow failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	return res.(bool), nil
+}
+
+// rebuildLikeSet 从post_like读取帖子的全部点赞用户写入redis集合
+func (repo *PostRepo) rebuildLikeSet(ctx context.Context, pid int64) error {
+	sqlStr := `select uid from post_like where pid = $1`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pid)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowTo[int64])
+	})
+	if err != nil {
+		return err
+	}
+	uids := res.([]int64)
+	members := make([]interface{}, 0, len(uids)+1)
+	members = append(members, likeSetPlaceholder)
+	for _, uid := range uids {
+		members = append(members, uid)
+	}
+
+	key := fmt.Sprintf(common.RKeyPostLike, pid)
+	pipe := repo.data.Rcli.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.SAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, likeSetTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// AddPostLikeRecord 写入点赞记录，返回false表示已经点赞过
+func (repo *PostRepo) AddPostLikeRecord(ctx context.Context, pid, uid int64) (bool, error) {
+	sqlStr := `
+	insert into post_like(uid, pid) values($1, $2)
+	on conflict (uid, pid) do nothing`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, uid, pid)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "AddPostLikeRecord/Exec failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	if res.(int64) == 0 {
+		return false, nil
+	}
+	key := fmt.Sprintf(common.RKeyPostLike, pid)
+	if err := saddIfExistsScript.Run(ctx, repo.data.Rcli, []string{key}, uid).Err(); err != nil {
+		// 删除集合，下次读取时重建
+		repo.log.Errorw(
+			"[repo]", "AddPostLikeRecord/saddIfExists failed",
+			"err", err,
+			"pid", pid,
+		)
+		repo.data.Rcli.Del(ctx, key)
+	}
+	return true, nil
+}
+
+// DelPostLikeRecord 删除点赞记录，返回false表示没有点赞过
+func (repo *PostRepo) DelPostLikeRecord(ctx context.Context, pid, uid int64) (bool, error) {
+	sqlStr := `delete from post_like where uid = $1 and pid = $2`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, uid, pid)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "DelPostLikeRecord/Exec failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	if res.(int64) == 0 {
+		return false, nil
+	}
+	key := fmt.Sprintf(common.RKeyPostLike, pid)
+	if err := repo.data.Rcli.SRem(ctx, key, uid).Err(); err != nil {
+		repo.log.Errorw(
+			"[repo]", "DelPostLikeRecord/SRem failed",
+			"err", err,
+			"pid", pid,
+		)
+		repo.data.Rcli.Del(ctx, key)
+	}
+	return true, nil
+}
+
+// ListLikedPids 按点赞时间倒序列出用户点赞过的帖子
+func (repo *PostRepo) ListLikedPids(ctx context.Context, uid, page, pageSize int64) ([]int64, error) {
+	sqlStr := `
+	select pid from post_like
+	where uid = $1
+	order by create_time desc, pid desc
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, uid, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowTo[int64])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListLikedPids/Query failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return res.([]int64), nil
+}
+
+// MigrateLikeSets 将只存在于redis集合中的点赞导入post_like，已存在的记录会被忽略
+func (repo *PostRepo) MigrateLikeSets(ctx context.Context) (int64, error) {
+	sqlStr := `
+	insert into post_like(uid, pid)
+	select unnest($1::bigint[]), $2
+	on conflict (uid, pid) do nothing`
+	prefix := strings.TrimSuffix(common.RKeyPostLike, "%v")
+	var total int64
+	iter := repo.data.Rcli.Scan(ctx, 0, prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		pid, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		members, err := repo.data.Rcli.SMembers(ctx, key).Result()
+		if err != nil {
+			return total, err
+		}
+		uids := make([]int64, 0, len(members))
+		for _, member := range members {
+			uid, err := strconv.ParseInt(member, 10, 64)
+			if err != nil || uid == likeSetPlaceholder {
+				continue
+			}
+			uids = append(uids, uid)
+		}
+		if len(uids) == 0 {
+			continue
+		}
+		tag, err := repo.data.PgxCli.Exec(ctx, sqlStr, uids, pid)
+		if err != nil {
+			return total, err
+		}
+		total += tag.RowsAffected()
+	}
+	return total, iter.Err()
+}
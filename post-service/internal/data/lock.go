@@ -0,0 +1,35 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"post-service/third_party/redislock"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// NewLocker 帖子编辑与定时任务共用的分布式锁
+func NewLocker(d *Data) *redislock.Client {
+	return redislock.New(d.Rcli)
+}
+
+// checkFence 在事务中校验ctx携带的栅栏令牌，令牌小于已写入的令牌时返回false，ctx未携带令牌时不校验
+// 校验时会锁住lock_fence中的行，持有旧令牌的写入会等到新令牌的事务结束后失败
+func checkFence(ctx context.Context, tx pgx.Tx) (bool, error) {
+	f, ok := redislock.FenceFromContext(ctx)
+	if !ok {
+		return true, nil
+	}
+	var fence int64
+	err := tx.QueryRow(ctx, `
+	insert into lock_fence (name, fence)
+	values ($1, $2)
+	on conflict (name) do update set fence = excluded.fence
+	where lock_fence.fence <= excluded.fence
+	returning fence`, f.Key, f.Token).Scan(&fence)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
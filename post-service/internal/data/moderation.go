@@ -0,0 +1,149 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ListPendingPosts 按发帖时间正序列出待审核的帖子，先发的先审，boardId为0时不按版块过滤
+func (repo *PostRepo) ListPendingPosts(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error) {
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status = 0 and ($3::bigint = 0 or board_id = $3)
+	order by create_time, pid
+	limit $1 offset $2`
+	return repo.queryPostPreviews(ctx, sqlStr, pageSize, page*pageSize, boardId)
+}
+
+// ListPendingPostsByUid 作者自己待审核的帖子，按发帖时间倒序，boardId为0时不按版块过滤
+func (repo *PostRepo) ListPendingPostsByUid(ctx context.Context, uid, boardId, limit int64) ([]*model.PostPreview, error) {
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status = 0 and uid = $1 and ($2::bigint = 0 or board_id = $2)
+	order by create_time desc, pid desc
+	limit $3`
+	return repo.queryPostPreviews(ctx, sqlStr, uid, boardId, limit)
+}
+
+// ModeratePost 将待审核的帖子改为status，同一事务中写入审核记录与给作者的通知
+// 帖子不存在或已被审核时返回nil
+func (repo *PostRepo) ModeratePost(ctx context.Context, pid int64, status int32, moderator int64, reason string) (*model.Post, error) {
+	action, notifyType := "approve", model.NotifyPostApproved
+	if status != model.StatusNormal {
+		action, notifyType = "reject", model.NotifyPostRejected
+	}
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		// 审核结果同样递增版本号，避免作者用审核前读到的版本覆盖审核结果
+		post := new(model.Post)
+		if err := tx.QueryRow(ctx, `
+		update post_info
+		set status = $2, version = version + 1
+		where pid = $1 and is_del = 0 and status = 0
+		returning id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like", comment_count, favorite_count, version, board_id`,
+			pid, status).Scan(post.ScanArgs()...); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return (*model.Post)(nil), nil
+			}
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `
+		insert into post_moderation_log(pid, moderator_uid, action, reason)
+		values ($1, $2, $3, $4)`,
+			pid, moderator, action, reason); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `
+		insert into post_notification(uid, type, pid, content)
+		values ($1, $2, $3, $4)`,
+			post.Uid, notifyType, pid, reason); err != nil {
+			return nil, err
+		}
+		return post, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ModeratePost/Tx failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+
+	post := res.(*model.Post)
+	if post == nil {
+		return nil, nil
+	}
+	// 审核通过后才进入排行榜，排行榜写入失败时由定时重建修复
+	if err := repo.syncHotRank(ctx, post); err != nil {
+		repo.log.Errorw(
+			"[repo]", "ModeratePost/syncHotRank failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+	if err := delCacheAfterWrite(ctx, repo, pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "ModeratePost/DelPostFC failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+	return post, nil
+}
+
+// ListNotifications 按时间倒序列出用户的通知
+func (repo *PostRepo) ListNotifications(ctx context.Context, uid, page, pageSize int64) ([]*model.Notification, error) {
+	sqlStr := `
+	select id, uid, type, pid, content, is_read = 1 as is_read, create_time
+	from post_notification
+	where uid = $1
+	order by id desc
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, uid, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.Notification])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListNotifications/Query failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return res.([]*model.Notification), nil
+}
+
+// MarkNotificationsRead 将id不大于maxId的通知标记为已读，返回标记的数量
+func (repo *PostRepo) MarkNotificationsRead(ctx context.Context, uid, maxId int64) (int64, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tag, err := repo.data.PgxCli.Exec(ctx, `
+		update post_notification
+		set is_read = 1
+		where uid = $1 and id <= $2 and is_read = 0`, uid, maxId)
+		return tag.RowsAffected(), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "MarkNotificationsRead/Exec failed",
+			"err", err,
+			"uid", uid,
+		)
+		return 0, err
+	}
+	return res.(int64), nil
+}
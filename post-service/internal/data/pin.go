@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/biz"
+	"post-service/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PinPost 置顶帖子，已置顶时更新过期时间，全局置顶同时将帖子状态改为4
+// 帖子不存在或不可见时返回false，范围内除该帖子以外未过期的置顶数达到maxPins时返回biz.ErrTooManyPins
+func (repo *PostRepo) PinPost(ctx context.Context, pin *model.Pin, maxPins int64, now time.Time) (bool, error) {
+	var full bool
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		full = false
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		// 同一范围的置顶依次执行，计数与写入之间不会有其他置顶插入
+		if _, err := tx.Exec(ctx, `
+		select pg_advisory_xact_lock(hashtext('post_pin:' || $1::text || ':' || $2::text))`, pin.Scope, pin.ScopeKey); err != nil {
+			return nil, err
+		}
+		var n int64
+		if err := tx.QueryRow(ctx, `
+		select count(*) from post_pin
+		where scope = $1 and scope_key = $2 and pid <> $3 and (expire_time is null or expire_time > $4)`,
+			pin.Scope, pin.ScopeKey, pin.Pid, now.UTC()).Scan(&n); err != nil {
+			return nil, err
+		}
+		// 业务错误不能返回给熔断器
+		if n >= maxPins {
+			full = true
+			return false, nil
+		}
+
+		var status int32
+		err = tx.QueryRow(ctx, `
+		select status from post_info where pid = $1 and is_del = 0 and status in (1, 4) for update`, pin.Pid).Scan(&status)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `
+		insert into post_pin(pid, scope, scope_key, pinner_uid, expire_time)
+		values ($1, $2, $3, $4, $5)
+		on conflict (scope, scope_key, pid)
+		do update set pinner_uid = excluded.pinner_uid, expire_time = excluded.expire_time, create_time = current_timestamp`,
+			pin.Pid, pin.Scope, pin.ScopeKey, pin.PinnerUid, timeArg(pin.ExpireTime)); err != nil {
+			return nil, err
+		}
+		if pin.Scope == model.PinScopeGlobal && status != model.StatusPinned {
+			if _, err := tx.Exec(ctx, `
+			update post_info set status = 4, version = version + 1 where pid = $1`, pin.Pid); err != nil {
+				return nil, err
+			}
+		}
+		return true, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "PinPost/Tx failed",
+			"err", err,
+			"pid", pin.Pid,
+		)
+		return false, err
+	}
+	if full {
+		return false, biz.ErrTooManyPins
+	}
+	if err := delCacheAfterWrite(ctx, repo, pin.Pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "PinPost/DelPostFC failed",
+			"err", err,
+			"pid", pin.Pid,
+		)
+	}
+	return res.(bool), nil
+}
+
+// UnpinPost 取消置顶，取消全局置顶时帖子状态恢复为1，帖子未在该范围置顶时返回false
+func (repo *PostRepo) UnpinPost(ctx context.Context, pid int64, scope, scopeKey string) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		tag, err := tx.Exec(ctx, `
+		delete from post_pin where scope = $1 and scope_key = $2 and pid = $3`, scope, scopeKey, pid)
+		if err != nil {
+			return nil, err
+		}
+		if tag.RowsAffected() == 0 {
+			return false, nil
+		}
+		if scope == model.PinScopeGlobal {
+			if _, err := tx.Exec(ctx, `
+			update post_info set status = 1, version = version + 1 where pid = $1 and status = 4`, pid); err != nil {
+				return nil, err
+			}
+		}
+		return true, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "UnpinPost/Tx failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	if err := delCacheAfterWrite(ctx, repo, pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "UnpinPost/DelPostFC failed",
+			"err", err,
+			"pid", pid,
+		)
+	}
+	return res.(bool), nil
+}
+
+// ListPinnedPosts 范围内未过期且可见的置顶帖子，后置顶的在前
+func (repo *PostRepo) ListPinnedPosts(ctx context.Context, scope, scopeKey string, now time.Time) ([]*model.PostPreview, error) {
+	sqlStr := `
+	select p.id, p.pid, p.create_time, p.update_time, p.title, p.content, p.author, p.status, p.score, p.tags, p.view, p."like", p.comment_count, p.favorite_count, p.board_id
+	from post_pin t
+	join post_info p on p.pid = t.pid
+	where t.scope = $1 and t.scope_key = $2 and (t.expire_time is null or t.expire_time > $3)
+		and p.is_del = 0 and p.status in (1, 4)
+	order by t.create_time desc, t.pid desc`
+	posts, err := repo.queryPostPreviews(ctx, sqlStr, scope, scopeKey, now.UTC())
+	if err != nil {
+		return nil, err
+	}
+	for _, post := range posts {
+		post.Pinned = true
+	}
+	return posts, nil
+}
+
+// ExpirePins 删除过期的置顶，并将全局置顶过期的帖子状态从4恢复为1，返回状态被恢复的帖子
+func (repo *PostRepo) ExpirePins(ctx context.Context, now time.Time) ([]int64, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		// 只恢复全局置顶刚过期的帖子，版主通过UpdatePost设置的状态4不受影响
+		rows, err := tx.Query(ctx, `
+		with expired as (
+			delete from post_pin
+			where expire_time is not null and expire_time <= $1
+			returning pid, scope
+		)
+		update post_info p set status = 1, version = version + 1
+		where p.pid in (select pid from expired where scope = 'global') and p.status = 4 and p.is_del = 0
+		returning p.pid`, now.UTC())
+		if err != nil {
+			return nil, err
+		}
+		pids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+		if err != nil {
+			return nil, err
+		}
+		return pids, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ExpirePins/Tx failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	pids := res.([]int64)
+	for _, pid := range pids {
+		if err := delCacheAfterWrite(ctx, repo, pid); err != nil {
+			repo.log.Errorw(
+				"[repo]", "ExpirePins/DelPostFC failed",
+				"err", err,
+				"pid", pid,
+			)
+		}
+	}
+	return pids, nil
+}
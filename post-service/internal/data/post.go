@@ -3,12 +3,15 @@ package data
 import (
 	"context"
 	"errors"
+	"fmt"
 	"post-service/internal/biz"
 	"post-service/internal/model"
+	"post-service/third_party/excerpt"
+	"post-service/third_party/redislock"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jackc/pgx/v5"
-	"github.com/redis/go-redis/v9"
 	"github.com/sony/gobreaker"
 	"golang.org/x/sync/singleflight"
 )
@@ -44,10 +47,6 @@ func NewPostRepo(data *Data, logger log.Logger) biz.PostRepo {
 	return repo
 }
 
-func (repo *PostRepo) RedisClient() *redis.Client {
-	return repo.data.Rcli
-}
-
 func NewPostRepoForJob(data *Data, logger log.Logger) *PostRepo {
 	return &PostRepo{
 		data: data,
@@ -55,15 +54,18 @@ func NewPostRepoForJob(data *Data, logger log.Logger) *PostRepo {
 	}
 }
 
+// sqlInsertPost 直接发帖与发布草稿共用
+const sqlInsertPost = `
+	insert into post_info(pid, title, content, author, uid, score, tags, status, board_id, search_title, search_author, search_tags, search_body)
+	values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	returning id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like", comment_count, favorite_count, version, board_id;`
+
 func (repo *PostRepo) CreatePost(ctx context.Context, post *model.CreatePostParam) (*model.Post, error) {
-	sqlStr := `
-	insert into post_info(pid, title, content, author, uid, score, tags)
-	values($1, $2, $3, $4, $5, $6, $7)
-	returning id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like";`
 	replyPost := new(model.Post)
 
 	res, err := pgBreaker.Execute(func() (interface{}, error) {
-		if err := repo.data.PgxCli.QueryRow(ctx, sqlStr, post.ToArgs()...).
+		args := append(post.ToArgs(), searchArgs(post.Title, post.Content, post.Author, post.Tags)...)
+		if err := repo.data.PgxCli.QueryRow(ctx, sqlInsertPost, args...).
 			Scan(replyPost.ScanArgs()...); err != nil {
 			repo.log.Errorw(
 				"[repo]", "CreatePost/QueryRow failed",
@@ -82,10 +84,10 @@ func (repo *PostRepo) CreatePost(ctx context.Context, post *model.CreatePostPara
 		return nil, err
 	}
 
-	// 将新创建的帖子加入排行榜
-	if err := repo.AddHotRank(ctx, replyPost.Pid, replyPost.Score); err != nil {
+	// 将新创建的帖子加入排行榜，需要审核的帖子审核通过后再加入
+	if err := repo.syncHotRank(ctx, replyPost); err != nil {
 		repo.log.Errorw(
-			"[repo]", "CreatePost/AddHotRank failed",
+			"[repo]", "CreatePost/syncHotRank failed",
 			"pid", replyPost.Pid,
 		)
 		return nil, err
@@ -98,48 +100,91 @@ func (repo *PostRepo) CreatePost(ctx context.Context, post *model.CreatePostPara
 	return res.(*model.Post), err
 }
 
+// UpdatePost 版本号与post.Version一致时更新并将版本号加1，否则返回biz.ErrPostVersionConflict
+// 未填写的字段以加锁读到的行为准，不使用读取之后可能已过期的内容
+// ctx携带的栅栏令牌已过期时返回redislock.ErrFenceStale
 func (repo *PostRepo) UpdatePost(ctx context.Context, post *model.UpdatePostParam) (*model.Post, error) {
-	// 做param的指针检查
-	if post.Title == nil || post.Content == nil || post.Status == nil || post.Score == nil || post.Tags == nil {
-		repo.log.Errorw(
-			"[repo]", "UpdatePost/Param check failed",
-			"err", errors.New("param pointer is nil"),
-			"title", post.Title,
-			"content", post.Content,
-			"status", post.Status,
-			"score", post.Score,
-			"tags", post.Tags,
-		)
-		return nil, errors.New("param pointer is nil")
-	}
-
 	sqlStr := `
 	update post_info
-	set pid = $1, title = $2, content = $3, status = $4, score = $5, tags = $6
-	where pid = $7
-	returning id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like";`
+	set pid = $1, title = $2, content = $3, status = $4, tags = $5,
+		search_title = $7, search_author = $8, search_tags = $9, search_body = $10, version = version + 1
+	where pid = $6 and is_del = 0 and version = $11
+	returning id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like", comment_count, favorite_count, version, board_id;`
 
+	// 令牌过期属于业务结果，不作为错误返回，避免触发熔断
+	var stale bool
 	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		// 锁住帖子并取出修改前的内容，同一帖子的编辑依次写入编辑历史
+		old := new(model.Revision)
+		var (
+			author  string
+			status  int32
+			version int64
+		)
+		if err := tx.QueryRow(ctx, `
+		select pid, uid, title, content, tags, update_time, author, status, version
+		from post_info
+		where pid = $1 and is_del = 0
+		for update`, post.Pid).
+			Scan(&old.Pid, &old.EditorUid, &old.Title, &old.Content, &old.Tags, &old.CreateTime, &author, &status, &version); err != nil {
+			return nil, err
+		}
+		if version != post.Version {
+			return (*model.Post)(nil), nil
+		}
+		ok, err := checkFence(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			stale = true
+			return (*model.Post)(nil), nil
+		}
+		fillUpdateParam(post, old, status)
+
 		replyPost := new(model.Post)
 		agrs := append(post.ToArgs(), post.Pid)
-		if err := repo.data.PgxCli.QueryRow(ctx, sqlStr, agrs...).Scan(replyPost.ScanArgs()...); err != nil {
+		agrs = append(agrs, searchArgs(*post.Title, *post.Content, author, post.Tags)...)
+		agrs = append(agrs, post.Version)
+		if err := tx.QueryRow(ctx, sqlStr, agrs...).Scan(replyPost.ScanArgs()...); err != nil {
 			repo.log.Errorw(
 				"[repo]", "UpdatePost/QueryRow failed",
 				"err", err,
 			)
 			return nil, err
 		}
-		return replyPost, nil
+		if revisionChanged(old, replyPost) {
+			if err := insertRevision(ctx, tx, old, replyPost, post.Editor); err != nil {
+				repo.log.Errorw(
+					"[repo]", "UpdatePost/insertRevision failed",
+					"err", err,
+				)
+				return nil, err
+			}
+		}
+		return replyPost, tx.Commit(ctx)
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if stale {
+		return nil, redislock.ErrFenceStale
+	}
 	replyPost := res.(*model.Post)
-	// 更新成功后将帖子加入排行榜
-	if err := repo.AddHotRank(ctx, replyPost.Pid, replyPost.Score); err != nil {
+	if replyPost == nil {
+		return nil, biz.ErrPostVersionConflict
+	}
+	// 更新成功后按状态加入或移出排行榜
+	if err := repo.syncHotRank(ctx, replyPost); err != nil {
 		repo.log.Errorw(
-			"[repo]", "UpdatePost/AddHotRank failed",
+			"[repo]", "UpdatePost/syncHotRank failed",
 			"pid", replyPost.Pid,
 		)
 		return nil, err
@@ -155,29 +200,52 @@ func (repo *PostRepo) UpdatePost(ctx context.Context, post *model.UpdatePostPara
 	return replyPost, nil
 }
 
+// fillUpdateParam 用加锁读到的行补全未修改的字段，热度只由定时任务与互动写入，编辑时不修改
+func fillUpdateParam(post *model.UpdatePostParam, old *model.Revision, status int32) {
+	if post.Title == nil {
+		post.Title = &old.Title
+	}
+	if post.Content == nil {
+		post.Content = &old.Content
+	}
+	if post.Tags == nil {
+		post.Tags = old.Tags
+	}
+	if post.Status == nil {
+		post.Status = &status
+	}
+	// 可见的帖子修改后命中需要审核的词时重新进入审核
+	if post.Moderate && model.IsVisibleStatus(*post.Status) {
+		pending := model.StatusPending
+		post.Status = &pending
+	}
+}
+
 func (repo *PostRepo) DeletePost(ctx context.Context, id int64) error {
 	// 实现逻辑删除
 	sqlStr := `
 	update post_info
 	set is_del = null
-	where pid = $1`
+	where pid = $1
+	returning board_id`
 
-	_, err := pgBreaker.Execute(func() (interface{}, error) {
-		if _, err := repo.data.PgxCli.Exec(ctx, sqlStr, id); err != nil {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		var boardId int64
+		if err := repo.data.PgxCli.QueryRow(ctx, sqlStr, id).Scan(&boardId); err != nil && !errors.Is(err, pgx.ErrNoRows) {
 			repo.log.Errorw(
-				"[repo]", "DeletePost/Exec failed",
+				"[repo]", "DeletePost/QueryRow failed",
 				"err", err,
 				"pid", id,
 			)
 			return nil, err
 		}
-		return nil, nil
+		return boardId, nil
 	})
 	if err != nil {
 		return err
 	}
 
-	if err := repo.DelHotRankMem(ctx, id); err != nil {
+	if err := repo.DelHotRankMem(ctx, id, res.(int64)); err != nil {
 		repo.log.Errorw(
 			"[repo]", "DeletePost/DelHotRank failed",
 			"pid", id,
@@ -208,14 +276,14 @@ func (repo *PostRepo) GetPostById(ctx context.Context, pid int64) (*model.Post,
 	}
 	if postCache != nil {
 		// 缓存命中直接返回
-		return postCache, nil
+		return repo.mergePendingViews(ctx, postCache)[0], nil
 	}
 
 	// 缓存未命中或redis出错
-	// 使用singleflight防止缓存击穿
-	post, err, _ := sfg.Do(StrAccessPG, func() (interface{}, error) {
+	// 使用singleflight防止缓存击穿，key中需要包含pid，否则不同帖子的并发请求会共用同一个结果
+	post, err, _ := sfg.Do(fmt.Sprintf("%s:%d", StrAccessPG, pid), func() (interface{}, error) {
 		sqlStr := `
-		select id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like"
+		select id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like", comment_count, favorite_count, version, board_id
 		from post_info where pid = $1 and is_del = 0`
 		post := new(model.Post)
 		var retryErr error
@@ -255,33 +323,150 @@ func (repo *PostRepo) GetPostById(ctx context.Context, pid int64) (*model.Post,
 		return nil, err
 	}
 
-	return post.(*model.Post), nil
+	return repo.mergePendingViews(ctx, post.(*model.Post))[0], nil
 }
 
-func (repo *PostRepo) ListPostPreviewByTime(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error) {
+// BatchGetPostByIds 批量获取帖子，先读缓存，未命中的帖子一次性从pg查询并回填缓存
+// 返回的map中不包含不存在的帖子
+func (repo *PostRepo) BatchGetPostByIds(ctx context.Context, pids []int64) (map[int64]*model.Post, error) {
+	posts, misses, err := repo.GetPostFCByPids(ctx, pids)
+	if err != nil {
+		// 查缓存错误，全部回源pg
+		repo.log.Errorw(
+			"[repo]", "BatchGetPostByIds/GetPostFCByPids failed",
+			"err", err,
+		)
+		posts = make(map[int64]*model.Post, len(pids))
+		misses = pids
+	}
+	if len(misses) == 0 {
+		return repo.mergePendingViewsMap(ctx, posts), nil
+	}
+
+	sqlStr := `
+	select id, pid, is_del, create_time, update_time, title, content, author, uid, status, score, tags, view, "like", comment_count, favorite_count, version, board_id
+	from post_info where pid = any($1) and is_del = 0`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, misses)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.Post, error) {
+			post := new(model.Post)
+			err := row.Scan(post.ScanArgs()...)
+			return post, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "BatchGetPostByIds/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	found := res.([]*model.Post)
+	for _, post := range found {
+		posts[post.Pid] = post
+	}
+	if err := repo.SetPostFCByPids(ctx, found); err != nil {
+		repo.log.Errorw(
+			"[repo]", "BatchGetPostByIds/SetPostFCByPids failed",
+			"err", err,
+		)
+	}
+	return repo.mergePendingViewsMap(ctx, posts), nil
+}
+
+// ListPostPreviewByTime boardId为0时不按版块过滤
+func (repo *PostRepo) ListPostPreviewByTime(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error) {
 	sqlStr := `
-	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like"
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
 	from post_info 
-	where is_del = 0
+	where is_del = 0 and status in (1, 4) and ($3::bigint = 0 or board_id = $3)
 	order by update_time desc
 	limit $1 offset $2`
-	return repo.ListPostPreview(ctx, page, pageSize, sqlStr)
+	return repo.queryPostPreviews(ctx, sqlStr, pageSize, page*pageSize, boardId)
 }
 
-func (repo *PostRepo) ListPostPreviewByHotFallback(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error) {
+func (repo *PostRepo) ListPostPreviewByHotFallback(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error) {
 	sqlStr := `
-	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like"
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
 	from post_info 
-	where is_del = 0
+	where is_del = 0 and status in (1, 4) and ($3::bigint = 0 or board_id = $3)
 	order by score desc, view desc
 	limit $1 offset $2`
-	return repo.ListPostPreview(ctx, page, pageSize, sqlStr)
+	return repo.queryPostPreviews(ctx, sqlStr, pageSize, page*pageSize, boardId)
+}
+
+// ListPostPreviewByTimeCursor 按(update_time, pid)做keyset分页，cursor为nil时返回第一页
+func (repo *PostRepo) ListPostPreviewByTimeCursor(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error) {
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status in (1, 4) and ($2::bigint = 0 or board_id = $2)
+	order by update_time desc, pid desc
+	limit $1`
+	args := []any{pageSize, boardId}
+	if cursor != nil {
+		sqlStr = `
+		select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+		from post_info
+		where is_del = 0 and status in (1, 4) and ($2::bigint = 0 or board_id = $2) and (update_time, pid) < ($3, $4)
+		order by update_time desc, pid desc
+		limit $1`
+		args = append(args, time.UnixMicro(cursor.UpdateTime).UTC(), cursor.Pid)
+	}
+	posts, err := repo.queryPostPreviews(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(len(posts)) < pageSize {
+		return posts, nil, nil
+	}
+	last := posts[len(posts)-1]
+	return posts, &model.PostCursor{
+		Type:       biz.StrTime,
+		UpdateTime: last.UpdateTime.UnixMicro(),
+		Pid:        last.Pid,
+	}, nil
+}
+
+// ListPostPreviewByHotCursorFallback redis不可用时在pg中按(score, pid)做keyset分页
+func (repo *PostRepo) ListPostPreviewByHotCursorFallback(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error) {
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status in (1, 4) and ($2::bigint = 0 or board_id = $2)
+	order by score desc, pid desc
+	limit $1`
+	args := []any{pageSize, boardId}
+	if cursor != nil {
+		sqlStr = `
+		select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+		from post_info
+		where is_del = 0 and status in (1, 4) and ($2::bigint = 0 or board_id = $2) and (score, pid) < ($3, $4)
+		order by score desc, pid desc
+		limit $1`
+		args = append(args, cursor.Score, cursor.Pid)
+	}
+	posts, err := repo.queryPostPreviews(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(len(posts)) < pageSize {
+		return posts, nil, nil
+	}
+	last := posts[len(posts)-1]
+	return posts, &model.PostCursor{
+		Type:  biz.StrHot,
+		Score: last.Score,
+		Pid:   last.Pid,
+	}, nil
 }
 
-func (repo *PostRepo) ListPostPreview(ctx context.Context, page, pageSize int64, sqlStr string) ([]*model.PostPreview, error) {
-	// 可选：增加列表缓存，已经定义的参数(key)
+func (repo *PostRepo) queryPostPreviews(ctx context.Context, sqlStr string, args ...any) ([]*model.PostPreview, error) {
 	res, err := pgBreaker.Execute(func() (interface{}, error) {
-		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pageSize, page*pageSize)
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
 		return rows, err
 	})
 	if err != nil {
@@ -294,7 +479,7 @@ func (repo *PostRepo) ListPostPreview(ctx context.Context, page, pageSize int64,
 	rows := res.(pgx.Rows)
 	defer rows.Close()
 
-	posts := make([]*model.PostPreview, 0, pageSize)
+	posts := make([]*model.PostPreview, 0)
 	for rows.Next() {
 		temp := new(model.PostPreview)
 		if err := rows.Scan(temp.ScanArgs()...); err != nil {
@@ -304,6 +489,7 @@ func (repo *PostRepo) ListPostPreview(ctx context.Context, page, pageSize int64,
 			)
 			return nil, err
 		}
+		temp.Content = excerpt.Make(temp.Content, model.PreviewExcerptLen)
 		posts = append(posts, temp)
 	}
 	return posts, nil
@@ -19,3 +19,16 @@ func (repo *PostRepo) GetUserByUid(ctx context.Context, uid int64) (*model.User,
 	}
 	return user, nil
 }
+
+// IsSessionValid 会话未被吊销且未过期时返回true
+func (repo *PostRepo) IsSessionValid(ctx context.Context, uid int64, sid string) (bool, error) {
+	sqlStr := `
+	select count(*)
+	from session_info
+	where sid = ? and uid = ? and revoked = 0 and expire_at > current_timestamp`
+	var n int
+	if err := repo.data.MySqlCli.GetContext(ctx, &n, sqlStr, sid, uid); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
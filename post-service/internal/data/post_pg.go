@@ -2,28 +2,30 @@ package data
 
 import (
 	"context"
+	"post-service/internal/model"
 
 	"github.com/jackc/pgx/v5"
 )
 
-func (repo *PostRepo) GetRankFromPG(ctx context.Context, limitNum ...int) map[int64]int64 {
+// GetRankFromPG 读取可见帖子的热度，返回的帖子只有pid、版块与热度
+func (repo *PostRepo) GetRankFromPG(ctx context.Context, limitNum ...int) []*model.Post {
 	var sqlStr string
 	var limit int
 	var rows pgx.Rows
 	var err error
 	if len(limitNum) == 0 {
 		sqlStr = `
-			select pid, score
+			select pid, board_id, score
 			from post_info
-			where is_del = 0
+			where is_del = 0 and status in (1, 4)
 			`
 		rows, err = repo.data.PgxCli.Query(ctx, sqlStr)
 	} else if len(limitNum) == 1 {
 		limit = limitNum[0]
 		sqlStr = `
-			select pid, score
+			select pid, board_id, score
 			from post_info
-			where is_del = 0
+			where is_del = 0 and status in (1, 4)
 			order by score desc
 			limit $1`
 		rows, err = repo.data.PgxCli.Query(ctx, sqlStr, limit)
@@ -38,20 +40,17 @@ func (repo *PostRepo) GetRankFromPG(ctx context.Context, limitNum ...int) map[in
 	}
 	defer rows.Close()
 
-	hotMap := make(map[int64]int64, 100)
+	posts := make([]*model.Post, 0, 100)
 	for rows.Next() {
-		var (
-			pid   int64
-			score int64
-		)
-		if err := rows.Scan(&pid, &score); err != nil {
+		post := new(model.Post)
+		if err := rows.Scan(&post.Pid, &post.BoardId, &post.Score); err != nil {
 			repo.log.Errorw(
 				"data", "GetRankFromPG/Scan failed",
 				"err", err,
 			)
 			panic(err)
 		}
-		hotMap[pid] = score
+		posts = append(posts, post)
 	}
-	return hotMap
+	return posts
 }
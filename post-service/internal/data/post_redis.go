@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"post-service/internal/biz"
 	"post-service/internal/common"
 	"post-service/internal/model"
 	"strconv"
@@ -117,6 +118,68 @@ func (repo *PostRepo) SetPostFCByPid(ctx context.Context, post *model.Post, expi
 	return nil
 }
 
+// GetPostFCByPids 使用MGET批量读取缓存，返回命中的帖子与未命中的pid
+func (repo *PostRepo) GetPostFCByPids(ctx context.Context, pids []int64) (map[int64]*model.Post, []int64, error) {
+	keys := make([]string, 0, len(pids))
+	for _, pid := range pids {
+		keys = append(keys, GetPostInfoKey(pid))
+	}
+	vals, err := repo.data.Rcli.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	posts := make(map[int64]*model.Post, len(pids))
+	misses := make([]int64, 0)
+	for i, val := range vals {
+		postJson, ok := val.(string)
+		if !ok {
+			misses = append(misses, pids[i])
+			continue
+		}
+		post := new(model.Post)
+		if err := json.Unmarshal([]byte(postJson), post); err != nil {
+			repo.log.Errorw(
+				"[repo]", "GetPostFCByPids/Unmarshal failed",
+				"err", err,
+				"pid", pids[i],
+			)
+			misses = append(misses, pids[i])
+			continue
+		}
+		posts[pids[i]] = post
+	}
+	return posts, misses, nil
+}
+
+// SetPostFCByPids 使用pipeline批量写入缓存，过期时间与SetPostFCByPid一样轮询获取
+func (repo *PostRepo) SetPostFCByPids(ctx context.Context, posts []*model.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	res, err := repo.data.Rcli.IncrBy(ctx, common.RKeyExpTime, int64(len(posts))).Result()
+	if err != nil {
+		return err
+	}
+	if res > 1e6 {
+		if err := repo.data.Rcli.Set(ctx, common.RKeyExpTime, 1, 0).Err(); err != nil {
+			return err
+		}
+	}
+
+	pipe := repo.data.Rcli.Pipeline()
+	for i, post := range posts {
+		postJson, err := json.Marshal(post)
+		if err != nil {
+			return err
+		}
+		expTime := time.Duration(10+(res-int64(i))%40) * time.Minute // 10~50分钟
+		pipe.Set(ctx, GetPostInfoKey(post.Pid), postJson, expTime)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
 func (repo *PostRepo) DelPostFCByPid(ctx context.Context, pid int64) error {
 	_, err := redisBreaker.Execute(func() (interface{}, error) {
 		key := GetPostInfoKey(pid)
@@ -125,13 +188,27 @@ func (repo *PostRepo) DelPostFCByPid(ctx context.Context, pid int64) error {
 	return err
 }
 
-func (repo *PostRepo) AddHotRank(ctx context.Context, pid, score int64) error {
+// hotRankKey boardId为0时返回全站的热度排行榜
+func hotRankKey(boardId int64) string {
+	if boardId == 0 {
+		return common.RKeyPostHotRank
+	}
+	return fmt.Sprintf(common.RKeyBoardHotRank, boardId)
+}
+
+// hotRankMember 排行榜成员使用补零到19位的pid，分数相同时redis按成员字典序排列的结果与pg中按pid排序一致
+func hotRankMember(pid int64) string {
+	return fmt.Sprintf("%019d", pid)
+}
+
+// AddHotRank 将帖子同时加入全站与所属版块的热度排行榜
+func (repo *PostRepo) AddHotRank(ctx context.Context, pid, boardId, score int64) error {
 	_, err := redisBreaker.Execute(func() (interface{}, error) {
-		// 将帖子加入热度排行榜
-		if err := repo.data.Rcli.ZAdd(ctx, common.RKeyPostHotRank, redis.Z{
+		member := redis.Z{
 			Score:  float64(score),
-			Member: pid,
-		}).Err(); err != nil {
+			Member: hotRankMember(pid),
+		}
+		if err := repo.data.Rcli.ZAdd(ctx, common.RKeyPostHotRank, member).Err(); err != nil {
 			repo.log.Errorw(
 				"[repo]", "AddHotRank/ZAdd failed",
 				"err", err,
@@ -139,14 +216,26 @@ func (repo *PostRepo) AddHotRank(ctx context.Context, pid, score int64) error {
 			)
 			return nil, err
 		}
+		if boardId == 0 {
+			return nil, nil
+		}
+		if err := repo.data.Rcli.ZAdd(ctx, hotRankKey(boardId), member).Err(); err != nil {
+			repo.log.Errorw(
+				"[repo]", "AddHotRank/ZAdd board failed",
+				"err", err,
+				"pid", pid,
+				"board_id", boardId,
+			)
+			return nil, err
+		}
 		return nil, nil
 	})
 	return err
 }
 
-func (repo *PostRepo) DelHotRankMem(ctx context.Context, pid int64) error {
-	// 从热度排行榜中删除帖子
-	if err := repo.data.Rcli.ZRem(ctx, common.RKeyPostHotRank, pid).Err(); err != nil {
+// DelHotRankMem 从全站与所属版块的热度排行榜中删除帖子
+func (repo *PostRepo) DelHotRankMem(ctx context.Context, pid, boardId int64) error {
+	if err := repo.data.Rcli.ZRem(ctx, common.RKeyPostHotRank, hotRankMember(pid)).Err(); err != nil {
 		repo.log.Errorw(
 			"[repo]", "DelHotRank/ZRem failed",
 			"err", err,
@@ -154,9 +243,29 @@ func (repo *PostRepo) DelHotRankMem(ctx context.Context, pid int64) error {
 		)
 		return err
 	}
+	if boardId == 0 {
+		return nil
+	}
+	if err := repo.data.Rcli.ZRem(ctx, hotRankKey(boardId), hotRankMember(pid)).Err(); err != nil {
+		repo.log.Errorw(
+			"[repo]", "DelHotRank/ZRem board failed",
+			"err", err,
+			"pid", pid,
+			"board_id", boardId,
+		)
+		return err
+	}
 	return nil
 }
 
+// syncHotRank 可见的帖子加入热度排行榜，待审核与隐藏的帖子从排行榜中移除
+func (repo *PostRepo) syncHotRank(ctx context.Context, post *model.Post) error {
+	if model.IsVisibleStatus(post.Status) {
+		return repo.AddHotRank(ctx, post.Pid, post.BoardId, post.Score)
+	}
+	return repo.DelHotRankMem(ctx, post.Pid, post.BoardId)
+}
+
 func (repo *PostRepo) DelPostFCByKey(ctx context.Context, key string) error {
 	if err := repo.data.Rcli.Del(ctx, key).Err(); err != nil {
 		repo.log.Errorw(
@@ -169,16 +278,17 @@ func (repo *PostRepo) DelPostFCByKey(ctx context.Context, key string) error {
 	return nil
 }
 
-func (repo *PostRepo) ListPostPreviewByHot(ctx context.Context, page, pageSize int64) ([]*model.PostPreview, error) {
+func (repo *PostRepo) ListPostPreviewByHot(ctx context.Context, boardId, page, pageSize int64) ([]*model.PostPreview, error) {
 	// TODO:
 	// 1.从数据库中获取热度最高的post信息
 	// 2.将获取到的信息封装成model.PostPreview对象
 	start := page * pageSize
 	end := (page+1)*pageSize - 1
-	members, err := repo.data.Rcli.ZRangeWithScores(ctx, common.RKeyPostHotRank, start, end).Result()
+	// 热度越高分数越大，需要倒序读取
+	members, err := repo.data.Rcli.ZRevRangeWithScores(ctx, hotRankKey(boardId), start, end).Result()
 	if err != nil {
 		repo.log.Errorw(
-			"[repo]", "ListPostPreviewByHot/ZRangeWithScores failed",
+			"[repo]", "ListPostPreviewByHot/ZRevRangeWithScores failed",
 			"err", err,
 			"page", page,
 			"pageSize", pageSize,
@@ -221,16 +331,112 @@ func (repo *PostRepo) ListPostPreviewByHot(ctx context.Context, page, pageSize i
 	return PostPreviews, nil
 }
 
+// ListPostPreviewByHotCursor 使用ZREVRANGEBYSCORE按热度做游标分页，cursor为nil时返回第一页
+// 与游标分数相同的帖子按pid倒序排列，已经返回过的需要跳过，排序与ListPostPreviewByHotCursorFallback一致，boardId为0时不按版块过滤
+func (repo *PostRepo) ListPostPreviewByHotCursor(ctx context.Context, boardId int64, cursor *model.PostCursor, pageSize int64) ([]*model.PostPreview, *model.PostCursor, error) {
+	res, err := redisBreaker.Execute(func() (interface{}, error) {
+		max := "+inf"
+		count := pageSize
+		if cursor != nil {
+			max = strconv.FormatInt(cursor.Score, 10)
+			ties, err := repo.data.Rcli.ZCount(ctx, hotRankKey(boardId), max, max).Result()
+			if err != nil {
+				return nil, err
+			}
+			count += ties
+		}
+		return repo.data.Rcli.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
+			Key:     hotRankKey(boardId),
+			Start:   "-inf",
+			Stop:    max,
+			ByScore: true,
+			Rev:     true,
+			Count:   count,
+		}).Result()
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListPostPreviewByHotCursor/ZRangeArgsWithScores failed",
+			"err", err,
+		)
+		return nil, nil, err
+	}
+
+	members := make([]redis.Z, 0, pageSize)
+	pids := make([]int64, 0, pageSize)
+	for _, member := range res.([]redis.Z) {
+		pidStr, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		pid, err := strconv.ParseInt(pidStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		if cursor != nil && int64(member.Score) == cursor.Score && pid >= cursor.Pid {
+			continue
+		}
+		members = append(members, member)
+		pids = append(pids, pid)
+		if int64(len(pids)) == pageSize {
+			break
+		}
+	}
+
+	posts, err := repo.BatchGetPostByIds(ctx, pids)
+	if err != nil {
+		return nil, nil, err
+	}
+	previews := make([]*model.PostPreview, 0, len(pids))
+	for _, pid := range pids {
+		if post, ok := posts[pid]; ok {
+			previews = append(previews, post.ToPreview())
+		}
+	}
+	if int64(len(pids)) < pageSize {
+		return previews, nil, nil
+	}
+	// 游标以排行榜中的最后一个成员为准，即使该帖子已被删除
+	last := members[len(members)-1]
+	return previews, &model.PostCursor{
+		Type:  biz.StrHot,
+		Score: int64(last.Score),
+		Pid:   pids[len(pids)-1],
+	}, nil
+}
+
 func (repo *PostRepo) ExistedRsetMem(ctx context.Context, key string, mem any) (bool, error) {
 	return repo.data.Rcli.SIsMember(ctx, key, mem).Result()
 }
 
-func (repo *PostRepo) AddRsetMem(ctx context.Context, key string, mem any) error {
-	return repo.data.Rcli.SAdd(ctx, key, mem).Err()
+// AddRsetMem 返回false表示成员已存在
+func (repo *PostRepo) AddRsetMem(ctx context.Context, key string, mem any) (bool, error) {
+	n, err := repo.data.Rcli.SAdd(ctx, key, mem).Result()
+	return n == 1, err
+}
+
+// DelRsetMem 返回false表示成员不存在
+func (repo *PostRepo) DelRsetMem(ctx context.Context, key string, mem any) (bool, error) {
+	n, err := repo.data.Rcli.SRem(ctx, key, mem).Result()
+	return n == 1, err
 }
 
-func (repo *PostRepo) DelRsetMem(ctx context.Context, key string, mem any) error {
-	return repo.data.Rcli.SRem(ctx, key, mem).Err()
+// 会话存在时刷新last_seen，与user服务中的脚本保持一致
+var touchSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'last_seen', ARGV[1])
+	return 1
+end
+return 0`)
+
+// TouchSession 刷新会话的last_seen，返回false表示redis中没有该会话
+func (repo *PostRepo) TouchSession(ctx context.Context, sid string) (bool, error) {
+	key := fmt.Sprintf(common.RKeyUserSession, sid)
+	n, err := touchSessionScript.Run(ctx, repo.data.Rcli, []string{key}, time.Now().Unix()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
 }
 
 func GetPostInfoKey(pid int64) string {
@@ -285,14 +491,13 @@ func delCacheAfterWrite(ctx context.Context, repo *PostRepo, pid int64) error {
 func (repo *PostRepo) WarmUpPostCache(ctx context.Context) {
 	// 读取pg中热度前100的帖子，并且只需要pid与score
 	// 将kv对存入redis中
-	hotMap := repo.GetRankFromPG(ctx, 100)
-	for pid, score := range hotMap {
-		if err := repo.AddHotRank(ctx, pid, score); err != nil {
+	for _, post := range repo.GetRankFromPG(ctx, 100) {
+		if err := repo.AddHotRank(ctx, post.Pid, post.BoardId, post.Score); err != nil {
 			repo.log.Errorw(
 				"[repo]", "WarmUpPostCache/AddHotRank failed",
 				"err", err,
-				"pid", pid,
-				"score", score,
+				"pid", post.Pid,
+				"score", post.Score,
 			)
 			panic(err)
 		}
@@ -304,14 +509,13 @@ func (repo *PostRepo) WarmUpPostCache(ctx context.Context) {
 func (repo *PostRepo) WarmUpPostCacheAll(ctx context.Context) {
 	// 读取pg中所有的帖子
 	// 将kv对存入redis中
-	hotMap := repo.GetRankFromPG(ctx)
-	for pid, score := range hotMap {
-		if err := repo.AddHotRank(ctx, pid, score); err != nil {
+	for _, post := range repo.GetRankFromPG(ctx) {
+		if err := repo.AddHotRank(ctx, post.Pid, post.BoardId, post.Score); err != nil {
 			repo.log.Errorw(
 				"[repo]", "WarmUpPostCache/AddHotRank failed",
 				"err", err,
-				"pid", pid,
-				"score", score,
+				"pid", post.Pid,
+				"score", post.Score,
 			)
 			return
 		}
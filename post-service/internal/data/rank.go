@@ -0,0 +1,229 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// ListRecentPostStats 获取since之后发布的帖子的互动数据，用于重新计算热度
+func (repo *PostRepo) ListRecentPostStats(ctx context.Context, since time.Time) ([]*model.PostStat, error) {
+	sqlStr := `
+	select pid, create_time, view, "like", comment_count
+	from post_info
+	where is_del = 0 and create_time >= $1`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, since.UTC())
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.PostStat, error) {
+			stat := new(model.PostStat)
+			err := row.Scan(&stat.Pid, &stat.CreateTime, &stat.View, &stat.Like, &stat.CommentCount)
+			return stat, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListRecentPostStats/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.PostStat), nil
+}
+
+// UpdateHotScores 批量写入热度，pg与全站、版块的热度排行榜同时更新
+// 排行榜只更新已有的成员，避免把刚删除的帖子重新加入，写入后删除帖子缓存
+func (repo *PostRepo) UpdateHotScores(ctx context.Context, scores map[int64]int64) error {
+	if len(scores) == 0 {
+		return nil
+	}
+	pids := make([]int64, 0, len(scores))
+	values := make([]int64, 0, len(scores))
+	for pid, score := range scores {
+		pids = append(pids, pid)
+		values = append(values, score)
+	}
+
+	sqlStr := `
+	update post_info
+	set score = v.score
+	from unnest($1::bigint[], $2::bigint[]) as v(pid, score)
+	where post_info.pid = v.pid and post_info.is_del = 0
+	returning post_info.pid, post_info.board_id, post_info.score`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pids, values)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.Post, error) {
+			post := new(model.Post)
+			err := row.Scan(&post.Pid, &post.BoardId, &post.Score)
+			return post, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "UpdateHotScores/Query failed",
+			"err", err,
+		)
+		return err
+	}
+	posts := res.([]*model.Post)
+	if len(posts) == 0 {
+		return nil
+	}
+
+	members := make(map[string][]redis.Z)
+	for _, post := range posts {
+		member := redis.Z{Score: float64(post.Score), Member: hotRankMember(post.Pid)}
+		members[common.RKeyPostHotRank] = append(members[common.RKeyPostHotRank], member)
+		if post.BoardId != 0 {
+			key := hotRankKey(post.BoardId)
+			members[key] = append(members[key], member)
+		}
+	}
+	// 帖子缓存中的热度已经过期，删除后重新从pg读取
+	keys := make([]string, 0, len(posts))
+	for _, post := range posts {
+		keys = append(keys, GetPostInfoKey(post.Pid))
+	}
+	_, err = redisBreaker.Execute(func() (interface{}, error) {
+		pipe := repo.data.Rcli.Pipeline()
+		for key, zs := range members {
+			pipe.ZAddXX(ctx, key, zs...)
+		}
+		pipe.Del(ctx, keys...)
+		_, err := pipe.Exec(ctx)
+		return nil, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "UpdateHotScores/Exec failed",
+			"err", err,
+		)
+		return err
+	}
+	return nil
+}
+
+// RebuildLeaderboard 按加权互动数重建榜单，since为nil时统计全部帖子
+// 先写入临时key再重命名，读取方不会看到构建到一半的榜单
+func (repo *PostRepo) RebuildLeaderboard(ctx context.Context, period string, since *time.Time, weights model.RankWeights, limit int64) error {
+	sqlStr := `
+	select pid, "like" * $1::float8 + comment_count * $2::float8 + view * $3::float8 as points
+	from post_info
+	where is_del = 0 and status in (1, 4) and ($5::timestamp is null or create_time >= $5)
+	order by points desc, pid desc
+	limit $4`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, weights.Like, weights.Comment, weights.View, limit, timeArg(since))
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (redis.Z, error) {
+			var (
+				pid    int64
+				points float64
+			)
+			err := row.Scan(&pid, &points)
+			return redis.Z{Score: points, Member: pid}, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RebuildLeaderboard/Query failed",
+			"err", err,
+			"period", period,
+		)
+		return err
+	}
+	members := res.([]redis.Z)
+
+	key := fmt.Sprintf(common.RKeyPostLeaderboard, period)
+	tmpKey := key + ":tmp"
+	_, err = redisBreaker.Execute(func() (interface{}, error) {
+		if len(members) == 0 {
+			return nil, repo.data.Rcli.Del(ctx, key).Err()
+		}
+		pipe := repo.data.Rcli.TxPipeline()
+		pipe.Del(ctx, tmpKey)
+		pipe.ZAdd(ctx, tmpKey, members...)
+		pipe.Rename(ctx, tmpKey, key)
+		_, err := pipe.Exec(ctx)
+		return nil, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RebuildLeaderboard/Exec failed",
+			"err", err,
+			"period", period,
+		)
+		return err
+	}
+	return nil
+}
+
+func (repo *PostRepo) ListPostPreviewByLeaderboard(ctx context.Context, period string, page, pageSize int64) ([]*model.PostPreview, error) {
+	key := fmt.Sprintf(common.RKeyPostLeaderboard, period)
+	start := page * pageSize
+	end := (page+1)*pageSize - 1
+	res, err := redisBreaker.Execute(func() (interface{}, error) {
+		return repo.data.Rcli.ZRevRange(ctx, key, start, end).Result()
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListPostPreviewByLeaderboard/ZRevRange failed",
+			"err", err,
+			"period", period,
+		)
+		return nil, err
+	}
+
+	members := res.([]string)
+	pids := make([]int64, 0, len(members))
+	for _, member := range members {
+		pid, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	posts, err := repo.BatchGetPostByIds(ctx, pids)
+	if err != nil {
+		return nil, err
+	}
+	previews := make([]*model.PostPreview, 0, len(pids))
+	for _, pid := range pids {
+		if post, ok := posts[pid]; ok {
+			previews = append(previews, post.ToPreview())
+		}
+	}
+	return previews, nil
+}
+
+// ListPostPreviewByLeaderboardFallback redis不可用时在pg中按同样的公式实时计算榜单
+func (repo *PostRepo) ListPostPreviewByLeaderboardFallback(ctx context.Context, since *time.Time, weights model.RankWeights, page, pageSize int64) ([]*model.PostPreview, error) {
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status in (1, 4) and ($6::timestamp is null or create_time >= $6)
+	order by "like" * $3::float8 + comment_count * $4::float8 + view * $5::float8 desc, pid desc
+	limit $1 offset $2`
+	return repo.queryPostPreviews(ctx, sqlStr, pageSize, page*pageSize, weights.Like, weights.Comment, weights.View, timeArg(since))
+}
+
+// timeArg 可选的时间以null传入sql，如总榜没有起始时间
+func timeArg(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
@@ -0,0 +1,367 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"post-service/internal/model"
+	"post-service/third_party/excerpt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const reportCaseColumns = `id, target_type, target_id, owner_uid, excerpt, weight, report_count, hidden = 1 as hidden, status, handler_uid, outcome, create_time, handle_time`
+
+// reportTarget 被举报的帖子或评论，Pid为帖子或评论所属的帖子
+type reportTarget struct {
+	typ     string
+	id      int64
+	pid     int64
+	owner   int64
+	status  int32
+	score   int64
+	board   int64
+	content string
+}
+
+// lockReportTarget 锁住被举报的内容，内容不存在时返回nil
+func lockReportTarget(ctx context.Context, tx pgx.Tx, targetType string, targetId int64) (*reportTarget, error) {
+	var sqlStr string
+	switch targetType {
+	case model.TargetPost:
+		sqlStr = `
+		select pid, uid, status, score, board_id, title || E'\n' || content
+		from post_info
+		where pid = $1 and is_del = 0
+		for update`
+	case model.TargetComment:
+		sqlStr = `
+		select pid, uid, status, 0, 0, content
+		from comment_info
+		where cid = $1 and is_del = 0
+		for update`
+	default:
+		return nil, fmt.Errorf("unknown report target type: %s", targetType)
+	}
+	t := &reportTarget{typ: targetType, id: targetId}
+	err := tx.QueryRow(ctx, sqlStr, targetId).Scan(&t.pid, &t.owner, &t.status, &t.score, &t.board, &t.content)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// visible 帖子的正常与置顶状态、评论的正常状态对所有人可见
+func (t *reportTarget) visible() bool {
+	if t.typ == model.TargetPost {
+		return model.IsVisibleStatus(t.status)
+	}
+	return t.status == model.StatusNormal
+}
+
+// setStatus 修改内容的状态，帖子同时递增版本号
+func (t *reportTarget) setStatus(ctx context.Context, tx pgx.Tx, status int32) error {
+	sqlStr := `update comment_info set status = $2 where cid = $1`
+	if t.typ == model.TargetPost {
+		sqlStr = `update post_info set status = $2, version = version + 1 where pid = $1`
+	}
+	if _, err := tx.Exec(ctx, sqlStr, t.id, status); err != nil {
+		return err
+	}
+	t.status = status
+	return nil
+}
+
+// syncReportTarget 内容状态变化后同步排行榜与缓存，失败只记录日志
+func (repo *PostRepo) syncReportTarget(ctx context.Context, t *reportTarget) {
+	if t.typ == model.TargetComment {
+		// 隐藏的评论移出热评排行，恢复时排行中没有的评论需要重建
+		var err error
+		if t.visible() {
+			err = repo.data.Rcli.Del(ctx, GetCommentRankKey(t.pid), GetCommentRankEmptyKey(t.pid)).Err()
+		} else {
+			err = repo.data.Rcli.ZRem(ctx, GetCommentRankKey(t.pid), t.id).Err()
+		}
+		if err != nil {
+			repo.log.Errorw(
+				"[repo]", "syncReportTarget/sync comment rank failed",
+				"err", err,
+				"cid", t.id,
+			)
+		}
+		repo.delCommentListFC(ctx, t.pid)
+		return
+	}
+	if err := repo.syncHotRank(ctx, &model.Post{Pid: t.pid, Status: t.status, Score: t.score, BoardId: t.board}); err != nil {
+		repo.log.Errorw(
+			"[repo]", "syncReportTarget/syncHotRank failed",
+			"err", err,
+			"pid", t.pid,
+		)
+	}
+	if err := delCacheAfterWrite(ctx, repo, t.pid); err != nil {
+		repo.log.Errorw(
+			"[repo]", "syncReportTarget/DelPostFC failed",
+			"err", err,
+			"pid", t.pid,
+		)
+	}
+}
+
+// GetCommentTarget 返回评论所属的帖子与评论的状态，用于举报前检查可见性，评论不存在时返回pgx.ErrNoRows
+func (repo *PostRepo) GetCommentTarget(ctx context.Context, cid int64) (int64, int32, error) {
+	type target struct {
+		pid    int64
+		status int32
+	}
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		var t target
+		err := repo.data.PgxCli.QueryRow(ctx, `
+		select pid, status from comment_info
+		where cid = $1 and is_del = 0`, cid).Scan(&t.pid, &t.status)
+		return t, err
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			repo.log.Errorw(
+				"[repo]", "GetCommentTarget/QueryRow failed",
+				"err", err,
+				"cid", cid,
+			)
+		}
+		return 0, 0, err
+	}
+	t := res.(target)
+	return t.pid, t.status, nil
+}
+
+// ReportContent 写入举报并累加到待处理的举报单，权重之和达到hideThreshold且举报人数达到hideMinReporters时自动隐藏内容
+// 内容不存在时返回nil，hideThreshold为0时不自动隐藏，置顶的帖子不会被自动隐藏
+func (repo *PostRepo) ReportContent(ctx context.Context, report *model.Report, hideThreshold, hideMinReporters int32) (*model.ReportResult, error) {
+	var changed *reportTarget
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		changed = nil
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		t, err := lockReportTarget(ctx, tx, report.TargetType, report.TargetId)
+		if err != nil || t == nil {
+			return (*model.ReportResult)(nil), err
+		}
+		result := new(model.ReportResult)
+		var weight, reporters int32
+		if err := tx.QueryRow(ctx, `
+		insert into content_report_case(target_type, target_id, owner_uid, excerpt, weight, report_count)
+		values ($1, $2, $3, $4, $5, 1)
+		on conflict (target_type, target_id) where status = 0
+		do update set weight = content_report_case.weight + excluded.weight,
+			report_count = content_report_case.report_count + 1
+		returning id, weight, report_count, hidden = 1`,
+			t.typ, t.id, t.owner, excerpt.Make(t.content, model.PreviewExcerptLen), report.Weight).
+			Scan(&result.CaseId, &weight, &reporters, &result.Hidden); err != nil {
+			return nil, err
+		}
+		err = tx.QueryRow(ctx, `
+		insert into content_report(case_id, target_type, target_id, reporter_uid, reason, detail, weight)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		on conflict (target_type, target_id, reporter_uid) do nothing
+		returning id`,
+			result.CaseId, t.typ, t.id, report.ReporterUid, report.Reason, report.Detail, report.Weight).Scan(&report.Id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// 重复举报时回滚对举报单的累加
+			return &model.ReportResult{CaseId: result.CaseId, Duplicated: true}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !result.Hidden && hideThreshold > 0 && weight >= hideThreshold && reporters >= hideMinReporters &&
+			t.visible() && t.status != model.StatusPinned {
+			prev := t.status
+			if err := t.setStatus(ctx, tx, model.StatusHidden); err != nil {
+				return nil, err
+			}
+			if _, err := tx.Exec(ctx, `
+			update content_report_case set hidden = 1, prev_status = $2 where id = $1`,
+				result.CaseId, prev); err != nil {
+				return nil, err
+			}
+			result.Hidden = true
+			changed = t
+		}
+		return result, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ReportContent/Tx failed",
+			"err", err,
+			"target_type", report.TargetType,
+			"target_id", report.TargetId,
+		)
+		return nil, err
+	}
+	if changed != nil {
+		repo.syncReportTarget(ctx, changed)
+	}
+	return res.(*model.ReportResult), nil
+}
+
+// HandleReportCase 版主处理举报单并记录处理结果，举报单不存在或已被处理时返回nil
+// 举报成立时隐藏内容，驳回时将自动隐藏的内容恢复为隐藏前的状态
+func (repo *PostRepo) HandleReportCase(ctx context.Context, caseId int64, status int32, handler int64, outcome string) (*model.ReportCase, error) {
+	var changed *reportTarget
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		changed = nil
+		var (
+			targetType string
+			targetId   int64
+		)
+		err := repo.data.PgxCli.QueryRow(ctx, `
+		select target_type, target_id from content_report_case where id = $1 and status = 0`, caseId).
+			Scan(&targetType, &targetId)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.ReportCase)(nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tx, err := repo.data.PgxCli.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback(ctx)
+
+		// 与ReportContent一样先锁内容再锁举报单，内容已被删除时只关闭举报单
+		t, err := lockReportTarget(ctx, tx, targetType, targetId)
+		if err != nil {
+			return nil, err
+		}
+		var (
+			hidden     bool
+			prevStatus int32
+		)
+		err = tx.QueryRow(ctx, `
+		select hidden = 1, prev_status from content_report_case where id = $1 and status = 0 for update`, caseId).
+			Scan(&hidden, &prevStatus)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.ReportCase)(nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case t == nil:
+		case status == model.ReportCaseResolved && !hidden && t.visible():
+			prevStatus = t.status
+			if err := t.setStatus(ctx, tx, model.StatusHidden); err != nil {
+				return nil, err
+			}
+			hidden = true
+			changed = t
+		case status == model.ReportCaseDismissed && hidden:
+			// 隐藏后被版主改为其他状态时不再恢复
+			if t.status == model.StatusHidden {
+				if err := t.setStatus(ctx, tx, prevStatus); err != nil {
+					return nil, err
+				}
+				changed = t
+			}
+			hidden = false
+		}
+
+		rows, err := tx.Query(ctx, `
+		update content_report_case
+		set status = $2, handler_uid = $3, outcome = $4, hidden = $5, prev_status = $6, handle_time = current_timestamp
+		where id = $1
+		returning `+reportCaseColumns,
+			caseId, status, handler, outcome, boolToSmallint(hidden), prevStatus)
+		if err != nil {
+			return nil, err
+		}
+		reportCase, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.ReportCase])
+		if err != nil {
+			return nil, err
+		}
+		return reportCase, tx.Commit(ctx)
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "HandleReportCase/Tx failed",
+			"err", err,
+			"case_id", caseId,
+		)
+		return nil, err
+	}
+	if changed != nil {
+		repo.syncReportTarget(ctx, changed)
+	}
+	return res.(*model.ReportCase), nil
+}
+
+// ListReportCases 待处理的举报单按权重倒序，已处理的按处理时间倒序
+func (repo *PostRepo) ListReportCases(ctx context.Context, status int32, page, pageSize int64) ([]*model.ReportCase, error) {
+	orderBy := "handle_time desc, id desc"
+	if status == model.ReportCasePending {
+		orderBy = "weight desc, id"
+	}
+	sqlStr := `
+	select ` + reportCaseColumns + `
+	from content_report_case
+	where status = $1
+	order by ` + orderBy + `
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, status, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.ReportCase])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListReportCases/Query failed",
+			"err", err,
+			"status", status,
+		)
+		return nil, err
+	}
+	return res.([]*model.ReportCase), nil
+}
+
+// ListReports 举报单中的举报记录，按举报时间正序
+func (repo *PostRepo) ListReports(ctx context.Context, caseId, page, pageSize int64) ([]*model.Report, error) {
+	sqlStr := `
+	select id, case_id, target_type, target_id, reporter_uid, reason, detail, weight, create_time
+	from content_report
+	where case_id = $1
+	order by id
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, caseId, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.Report])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListReports/Query failed",
+			"err", err,
+			"case_id", caseId,
+		)
+		return nil, err
+	}
+	return res.([]*model.Report), nil
+}
+
+func boolToSmallint(b bool) int16 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"post-service/internal/model"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// revisionChanged 只有标题、正文或标签变化时才记录编辑历史
+func revisionChanged(old *model.Revision, post *model.Post) bool {
+	return old.Title != post.Title || old.Content != post.Content || !slices.Equal(old.Tags, post.Tags)
+}
+
+// insertRevision 写入编辑后的快照，帖子没有编辑历史时先以作者和修改前的内容补录第1个版本
+// 调用方需要锁住帖子，保证版本号连续
+func insertRevision(ctx context.Context, tx pgx.Tx, old *model.Revision, post *model.Post, editor int64) error {
+	if _, err := tx.Exec(ctx, `
+	insert into post_revision(pid, rev, editor_uid, title, content, tags, create_time)
+	select $1, 1, $2, $3, $4, $5, $6
+	where not exists (select 1 from post_revision where pid = $1)`,
+		old.Pid, old.EditorUid, old.Title, old.Content, old.Tags, old.CreateTime); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `
+	insert into post_revision(pid, rev, editor_uid, title, content, tags)
+	select $1, coalesce(max(rev), 0) + 1, $2, $3, $4, $5
+	from post_revision
+	where pid = $1`,
+		post.Pid, editor, post.Title, post.Content, post.Tags)
+	return err
+}
+
+// ListRevisions 按版本号倒序列出编辑历史，不包含正文
+func (repo *PostRepo) ListRevisions(ctx context.Context, pid, page, pageSize int64) ([]*model.Revision, error) {
+	sqlStr := `
+	select pid, rev, editor_uid, title, tags, create_time
+	from post_revision
+	where pid = $1
+	order by rev desc
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pid, pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.Revision])
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListRevisions/Query failed",
+			"err", err,
+			"pid", pid,
+		)
+		return nil, err
+	}
+	return res.([]*model.Revision), nil
+}
+
+// GetRevision 返回nil表示版本不存在
+func (repo *PostRepo) GetRevision(ctx context.Context, pid int64, rev int32) (*model.Revision, error) {
+	sqlStr := `
+	select pid, rev, editor_uid, title, content, tags, create_time
+	from post_revision
+	where pid = $1 and rev = $2`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, pid, rev)
+		if err != nil {
+			return nil, err
+		}
+		revision, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Revision])
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*model.Revision)(nil), nil
+		}
+		return revision, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "GetRevision/Query failed",
+			"err", err,
+			"pid", pid,
+			"rev", rev,
+		)
+		return nil, err
+	}
+	return res.(*model.Revision), nil
+}
+
+func (repo *PostRepo) IsModerator(ctx context.Context, uid int64) (bool, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		var n int
+		err := repo.data.PgxCli.QueryRow(ctx, `select count(*) from post_moderator where uid = $1`, uid).Scan(&n)
+		return n > 0, err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "IsModerator/QueryRow failed",
+			"err", err,
+			"uid", uid,
+		)
+		return false, err
+	}
+	return res.(bool), nil
+}
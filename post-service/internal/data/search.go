@@ -0,0 +1,193 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"post-service/internal/model"
+	"post-service/third_party/excerpt"
+	"post-service/third_party/segment"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// 最终排序分 = 文本相关度 * searchTextWeight + 帖子热度在命中结果中的百分位 * searchScoreWeight
+	searchTextWeight  = 0.8
+	searchScoreWeight = 0.2
+
+	headlineOptions = "StartSel=<em>, StopSel=</em>, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" ... \""
+)
+
+// htmlEscapeSQL 高亮前转义用户文本，ts_headline的结果中只有<em>是标记，客户端可以直接按html渲染
+// 转义后的实体在分词时是独立的词，不影响查询词的匹配
+func htmlEscapeSQL(col string) string {
+	for _, r := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&quot;"}, {"''", "&#39;"}} {
+		col = fmt.Sprintf("replace(%s, '%s', '%s')", col, r[0], r[1])
+	}
+	return col
+}
+
+// tokenizer 写入索引与构造查询使用同一个分词器，替换实现后需要重建search_*列
+var tokenizer segment.Tokenizer = segment.NewUnigram()
+
+// searchArgs 生成写入search_title、search_author、search_tags、search_body的分词文本
+func searchArgs(title, content, author string, tags []string) []any {
+	return []any{
+		tokenizer.Segment(title),
+		tokenizer.Segment(author),
+		tokenizer.Segment(strings.Join(tags, " ")),
+		tokenizer.Segment(content),
+	}
+}
+
+// buildTSQuery 词组内的词使用<->要求相邻，词组之间为且的关系
+// Terms只返回字母与数字，不需要额外转义
+func buildTSQuery(query string) string {
+	terms := tokenizer.Terms(query)
+	groups := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if len(term) == 1 {
+			groups = append(groups, term[0])
+			continue
+		}
+		groups = append(groups, "("+strings.Join(term, " <-> ")+")")
+	}
+	return strings.Join(groups, " & ")
+}
+
+func (repo *PostRepo) SearchPosts(ctx context.Context, param *model.SearchPostsParam) ([]*model.SearchHit, error) {
+	tsQuery := buildTSQuery(param.Query)
+	if tsQuery == "" {
+		return []*model.SearchHit{}, nil
+	}
+
+	args := []any{tsQuery}
+	conds := []string{"is_del = 0", "status in (1, 4)", "search_vector @@ q"}
+	if param.Tag != "" {
+		args = append(args, param.Tag)
+		conds = append(conds, fmt.Sprintf("tags @> array[$%d]::varchar(64)[]", len(args)))
+	}
+	if param.Uid != 0 {
+		args = append(args, param.Uid)
+		conds = append(conds, fmt.Sprintf("uid = $%d", len(args)))
+	}
+	if param.Since != nil {
+		args = append(args, param.Since.UTC())
+		conds = append(conds, fmt.Sprintf("create_time >= $%d", len(args)))
+	}
+	if param.Until != nil {
+		args = append(args, param.Until.UTC())
+		conds = append(conds, fmt.Sprintf("create_time < $%d", len(args)))
+	}
+	args = append(args, param.PageSize, param.Page*param.PageSize)
+
+	sqlStr := fmt.Sprintf(`
+	with matched as (
+		select pid, ts_rank(search_vector, q) as text_rank, score
+		from post_info, to_tsquery('simple', $1) q
+		where %s
+	), ranked as (
+		select pid, text_rank * %v + percent_rank() over (order by score) * %v as rank
+		from matched
+		order by rank desc, pid desc
+		limit $%d offset $%d
+	)
+	select p.id, p.pid, p.create_time, p.update_time, p.title, p.content, p.author, p.status, p.score, p.tags, p.view, p."like", p.comment_count, p.favorite_count, p.board_id,
+		ts_headline('simple', %s, q, 'HighlightAll=true, StartSel=<em>, StopSel=</em>'),
+		ts_headline('simple', %s, q, '%s'),
+		r.rank
+	from ranked r
+	join post_info p on p.pid = r.pid and p.is_del = 0,
+	to_tsquery('simple', $1) q
+	order by r.rank desc, p.pid desc`,
+		strings.Join(conds, " and "), searchTextWeight, searchScoreWeight,
+		len(args)-1, len(args), htmlEscapeSQL("p.search_title"), htmlEscapeSQL("p.search_body"), headlineOptions)
+
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.SearchHit, error) {
+			hit := &model.SearchHit{Post: new(model.PostPreview)}
+			scanArgs := append(hit.Post.ScanArgs(), &hit.TitleHighlight, &hit.ContentHighlight, &hit.Rank)
+			err := row.Scan(scanArgs...)
+			return hit, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "SearchPosts/Query failed",
+			"err", err,
+			"query", param.Query,
+		)
+		return nil, err
+	}
+
+	hits := res.([]*model.SearchHit)
+	for _, hit := range hits {
+		hit.TitleHighlight = strings.TrimSpace(tokenizer.Restore(hit.TitleHighlight))
+		hit.ContentHighlight = strings.TrimSpace(tokenizer.Restore(hit.ContentHighlight))
+		hit.Post.Content = excerpt.Make(hit.Post.Content, model.PreviewExcerptLen)
+	}
+	return hits, nil
+}
+
+// BackfillSearch 为全文检索上线前的帖子补齐search_*列，返回本批更新的帖子数
+// 作者名不能为空，写入后的search_author也不为空，以此判断帖子是否已经补齐
+func (repo *PostRepo) BackfillSearch(ctx context.Context, batchSize int64) (int, error) {
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, `
+		select pid, title, content, author, tags
+		from post_info
+		where is_del = 0 and search_author = '' and author <> ''
+		limit $1`, batchSize)
+		if err != nil {
+			return nil, err
+		}
+		type post struct {
+			pid                    int64
+			title, content, author string
+			tags                   []string
+		}
+		posts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (post, error) {
+			var p post
+			err := row.Scan(&p.pid, &p.title, &p.content, &p.author, &p.tags)
+			return p, err
+		})
+		if err != nil || len(posts) == 0 {
+			return 0, err
+		}
+
+		pids := make([]int64, 0, len(posts))
+		titles := make([]string, 0, len(posts))
+		authors := make([]string, 0, len(posts))
+		tags := make([]string, 0, len(posts))
+		bodies := make([]string, 0, len(posts))
+		for _, p := range posts {
+			args := searchArgs(p.title, p.content, p.author, p.tags)
+			pids = append(pids, p.pid)
+			titles = append(titles, args[0].(string))
+			authors = append(authors, args[1].(string))
+			tags = append(tags, args[2].(string))
+			bodies = append(bodies, args[3].(string))
+		}
+		// search_author为空的条件避免覆盖同时被编辑的帖子
+		_, err = repo.data.PgxCli.Exec(ctx, `
+		update post_info
+		set search_title = v.title, search_author = v.author, search_tags = v.tags, search_body = v.body
+		from unnest($1::bigint[], $2::text[], $3::text[], $4::text[], $5::text[]) as v(pid, title, author, tags, body)
+		where post_info.pid = v.pid and post_info.is_del = 0 and post_info.search_author = ''`,
+			pids, titles, authors, tags, bodies)
+		return len(posts), err
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "BackfillSearch/Exec failed",
+			"err", err,
+		)
+		return 0, err
+	}
+	return res.(int), nil
+}
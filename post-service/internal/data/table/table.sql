@@ -28,11 +28,325 @@ CREATE TABLE post_info (
     author varchar(16) NOT NULL,
     uid bigint NOT NULL,
     status smallint NOT NULL DEFAULT 0, -- 0待审核，1正常，2隐藏，4置顶
-    score bigint NOT NULL, -- 热度 以ms为单位
+    score bigint NOT NULL, -- 热度，由重力公式计算后放大1e6倍取整
     tags varchar(64)[] NOT NULL,
     view bigint NOT NULL DEFAULT 0,
     "like" bigint NOT NULL DEFAULT 0,
+    comment_count bigint NOT NULL DEFAULT 0,
+    favorite_count bigint NOT NULL DEFAULT 0,
+    version bigint NOT NULL DEFAULT 1, -- 每次编辑后加1，更新时校验以避免覆盖他人的修改
+    board_id bigint NOT NULL DEFAULT 0, -- 所属版块
+    view_batch bigint NOT NULL DEFAULT 0, -- 最后一次写入的浏览数批次号，避免重试时重复累加
+
+    -- 全文检索，search_*为分词后以空格分隔的文本，由服务写入
+    search_title text NOT NULL DEFAULT '',
+    search_author text NOT NULL DEFAULT '',
+    search_tags text NOT NULL DEFAULT '',
+    search_body text NOT NULL DEFAULT '',
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', search_title), 'A') ||
+        setweight(to_tsvector('simple', search_author || ' ' || search_tags), 'B') ||
+        setweight(to_tsvector('simple', search_body), 'C')
+    ) STORED,
 
     primary key (id),
     unique (pid, is_del)
-);
\ No newline at end of file
+);
+-- 游标分页使用的keyset索引
+CREATE INDEX idx_post_update_time_pid ON post_info (update_time desc, pid desc) WHERE is_del = 0;
+CREATE INDEX idx_post_score_pid ON post_info (score desc, pid desc) WHERE is_del = 0;
+CREATE INDEX idx_post_tags ON post_info USING gin (tags) WHERE is_del = 0;
+CREATE INDEX idx_post_search ON post_info USING gin (search_vector) WHERE is_del = 0;
+CREATE INDEX idx_post_create_time ON post_info (create_time) WHERE is_del = 0;
+CREATE INDEX idx_post_pending ON post_info (create_time) WHERE is_del = 0 AND status = 0;
+CREATE INDEX idx_post_board_update_time_pid ON post_info (board_id, update_time desc, pid desc) WHERE is_del = 0;
+-- 旧数据的score为发帖时间，改用重力公式后执行一次，之后由定时任务维护近期帖子的热度
+-- UPDATE post_info SET score = 0 WHERE create_time < now() - interval '7 days';
+-- 增加乐观锁的版本号，执行后需要清除post:post_info:*缓存，否则客户端从缓存中读取到的版本号为0
+-- ALTER TABLE post_info ADD COLUMN version bigint NOT NULL DEFAULT 1;
+-- 审核上线前新帖子的状态都是0且对所有人可见，列表改为只显示状态1与4后执行一次
+-- UPDATE post_info SET status = 1 WHERE status = 0;
+-- 版块上线前的帖子没有版块，创建默认版块并把旧帖子移入后执行一次，之后清除post:post_info:*缓存并重启服务以重建各版块的热度排行榜
+-- ALTER TABLE post_info ADD COLUMN board_id bigint NOT NULL DEFAULT 0;
+-- ALTER TABLE post_draft ADD COLUMN board_id bigint NOT NULL DEFAULT 0;
+-- INSERT INTO board(name, slug, description) VALUES ('综合', 'general', '');
+-- UPDATE post_info SET board_id = (SELECT id FROM board WHERE slug = 'general') WHERE board_id = 0;
+-- 浏览数写入改为按批次去重后执行一次
+-- ALTER TABLE post_info ADD COLUMN view_batch bigint NOT NULL DEFAULT 0;
+-- 全文检索上线前的帖子search_*为空，分词只能在服务中完成，由SearchBackfillJob在服务启动时分批补齐
+-- 作者名改为分词后写入search_author，生成列不能修改表达式，需要删除后重建，之后由SearchBackfillJob补齐search_author
+-- ALTER TABLE post_info DROP COLUMN search_vector;
+-- ALTER TABLE post_info ADD COLUMN search_author text NOT NULL DEFAULT '';
+-- ALTER TABLE post_info ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple', search_title), 'A') || setweight(to_tsvector('simple', search_author || ' ' || search_tags), 'B') || setweight(to_tsvector('simple', search_body), 'C')) STORED;
+-- CREATE INDEX idx_post_search ON post_info USING gin (search_vector) WHERE is_del = 0;
+-- 热度排行榜的成员改为补零到19位的pid，上线时删除post:post_rank:hot*后重启服务重新预热，否则旧成员会重复出现
+
+-- 标签目录，name为统一格式后的标准名
+CREATE TABLE tag_info (
+    id bigserial not null,
+    name varchar(64) NOT NULL,
+    post_count bigint NOT NULL DEFAULT 0,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (name)
+);
+CREATE INDEX idx_tag_name_pattern ON tag_info (name varchar_pattern_ops);
+CREATE INDEX idx_tag_post_count ON tag_info (post_count desc);
+
+-- 标签别名，发帖与按标签查询时别名会被替换为标准名
+CREATE TABLE tag_alias (
+    alias varchar(64) NOT NULL,
+    name varchar(64) NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (alias)
+);
+
+CREATE TABLE comment_info (
+    id bigserial not null,
+    cid bigint NOT NULL,
+    is_del smallint DEFAULT 0,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+    update_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    pid bigint NOT NULL,
+    uid bigint NOT NULL,
+    author varchar(16) NOT NULL,
+    content text NOT NULL,
+    root_id bigint NOT NULL DEFAULT 0, -- 0表示一级评论，否则为所属一级评论的cid
+    reply_to_uid bigint NOT NULL DEFAULT 0, -- 被回复的用户，一级评论为0
+    "like" bigint NOT NULL DEFAULT 0,
+    reply_count bigint NOT NULL DEFAULT 0, -- 只有一级评论会记录回复数
+    status smallint NOT NULL DEFAULT 1, -- 1正常，2隐藏
+
+    primary key (id),
+    unique (cid, is_del)
+);
+CREATE INDEX idx_comment_pid_root ON comment_info (pid, root_id, create_time);
+CREATE INDEX idx_comment_root ON comment_info (root_id, create_time);
+-- ALTER TABLE comment_info ADD COLUMN status smallint NOT NULL DEFAULT 1;
+-- 评论列表缓存中可能有被隐藏的评论，上线时清除post:comment_list:*
+-- 点赞记录，redis中的post:post_like:<pid>集合只是缓存
+-- 上线前只存在于redis中的点赞由job中的MigrateLikeSets导入
+CREATE TABLE post_like (
+    id bigserial not null,
+    uid bigint NOT NULL,
+    pid bigint NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (uid, pid)
+);
+CREATE INDEX idx_post_like_uid_time ON post_like (uid, create_time desc);
+CREATE INDEX idx_post_like_pid ON post_like (pid);
+
+-- 已经写入post_info的点赞事件，用于消费点赞事件时去重，定期清理
+CREATE TABLE like_event_applied (
+    event_id bigint NOT NULL,
+    pid bigint NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (event_id)
+);
+CREATE INDEX idx_like_event_create_time ON like_event_applied (create_time);
+
+-- 用户自定义的收藏夹
+CREATE TABLE favorite_collection (
+    id bigserial not null,
+    collection_id bigint NOT NULL,
+    uid bigint NOT NULL,
+    name varchar(32) NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (collection_id),
+    unique (uid, name)
+);
+
+-- 收藏记录，帖子删除后保留，collection_id为0表示未分类
+CREATE TABLE favorite (
+    id bigserial not null,
+    uid bigint NOT NULL,
+    pid bigint NOT NULL,
+    collection_id bigint NOT NULL DEFAULT 0,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (uid, pid)
+);
+CREATE INDEX idx_favorite_uid_time ON favorite (uid, create_time desc);
+CREATE INDEX idx_favorite_uid_collection_time ON favorite (uid, collection_id, create_time desc);
+
+-- 草稿与定时发布的帖子，发布时在同一事务中删除草稿并写入post_info
+-- publish_at为null表示普通草稿，否则到时间后由定时任务发布
+CREATE TABLE post_draft (
+    id bigserial not null,
+    did bigint NOT NULL,
+    uid bigint NOT NULL,
+    title varchar(64) NOT NULL DEFAULT '',
+    content text NOT NULL DEFAULT '',
+    tags varchar(64)[] NOT NULL DEFAULT '{}',
+    board_id bigint NOT NULL DEFAULT 0, -- 为0时发布前需要选择版块
+    publish_at timestamp NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+    update_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (did)
+);
+CREATE INDEX idx_post_draft_uid_time ON post_draft (uid, update_time desc);
+CREATE INDEX idx_post_draft_publish_at ON post_draft (publish_at) WHERE publish_at IS NOT NULL;
+
+-- 帖子的编辑历史，每次修改标题、正文或标签后保存修改后的完整快照
+-- 第一次编辑时先补录编辑前的内容作为第1个版本
+CREATE TABLE post_revision (
+    id bigserial not null,
+    pid bigint NOT NULL,
+    rev int NOT NULL,
+    editor_uid bigint NOT NULL,
+    title varchar(64) NOT NULL,
+    content text NOT NULL,
+    tags varchar(64)[] NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (pid, rev)
+);
+
+-- 版主，可以恢复帖子的历史版本
+CREATE TABLE post_moderator (
+    uid bigint NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (uid)
+);
+
+-- 审核记录，action为approve或reject
+CREATE TABLE post_moderation_log (
+    id bigserial not null,
+    pid bigint NOT NULL,
+    moderator_uid bigint NOT NULL,
+    action varchar(16) NOT NULL,
+    reason varchar(200) NOT NULL DEFAULT '',
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id)
+);
+CREATE INDEX idx_post_moderation_log_pid ON post_moderation_log (pid, create_time);
+
+-- 发给用户的通知，与触发通知的操作在同一事务中写入
+CREATE TABLE post_notification (
+    id bigserial not null,
+    uid bigint NOT NULL,
+    type varchar(32) NOT NULL,
+    pid bigint NOT NULL,
+    content varchar(200) NOT NULL DEFAULT '',
+    is_read smallint NOT NULL DEFAULT 0,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id)
+);
+CREATE INDEX idx_post_notification_uid ON post_notification (uid, id desc);
+
+-- 敏感词命中记录，被拒绝发布的内容target_id为0
+CREATE TABLE content_filter_log (
+    id bigserial not null,
+    target_type varchar(16) NOT NULL, -- post或comment
+    target_id bigint NOT NULL DEFAULT 0,
+    uid bigint NOT NULL,
+    action varchar(16) NOT NULL, -- 最严格的处理方式：mask、moderate或reject
+    categories varchar(32)[] NOT NULL, -- 与terms一一对应
+    terms varchar(64)[] NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id)
+);
+CREATE INDEX idx_content_filter_log_target ON content_filter_log (target_type, target_id);
+
+-- 举报单，同一内容同一时间只有一个待处理的举报单，处理后再被举报时新建
+CREATE TABLE content_report_case (
+    id bigserial not null,
+    target_type varchar(16) NOT NULL, -- post或comment
+    target_id bigint NOT NULL,
+    owner_uid bigint NOT NULL, -- 被举报内容的作者
+    excerpt text NOT NULL DEFAULT '', -- 第一次被举报时的内容摘要
+    weight int NOT NULL DEFAULT 0, -- 举报权重之和
+    report_count int NOT NULL DEFAULT 0,
+    hidden smallint NOT NULL DEFAULT 0, -- 1表示内容已被隐藏，驳回时恢复为prev_status
+    prev_status smallint NOT NULL DEFAULT 1,
+    status smallint NOT NULL DEFAULT 0, -- 0待处理，1举报成立，2驳回
+    handler_uid bigint NOT NULL DEFAULT 0,
+    outcome varchar(200) NOT NULL DEFAULT '',
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+    handle_time timestamp NULL,
+
+    primary key (id)
+);
+CREATE UNIQUE INDEX uk_report_case_open ON content_report_case (target_type, target_id) WHERE status = 0;
+CREATE INDEX idx_report_case_status ON content_report_case (status, weight desc, id);
+
+-- 举报记录，同一用户对同一内容只能举报一次
+CREATE TABLE content_report (
+    id bigserial not null,
+    case_id bigint NOT NULL,
+    target_type varchar(16) NOT NULL,
+    target_id bigint NOT NULL,
+    reporter_uid bigint NOT NULL,
+    reason varchar(16) NOT NULL,
+    detail varchar(500) NOT NULL DEFAULT '',
+    weight int NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (target_type, target_id, reporter_uid)
+);
+CREATE INDEX idx_content_report_case ON content_report (case_id, id);
+
+-- 置顶，同一帖子可以在全局与多个标签下置顶，过期的置顶由job清理
+CREATE TABLE post_pin (
+    id bigserial not null,
+    pid bigint NOT NULL,
+    scope varchar(16) NOT NULL, -- global、tag或board
+    scope_key varchar(64) NOT NULL DEFAULT '', -- 标签名或版块id，全局置顶为空
+    pinner_uid bigint NOT NULL,
+    expire_time timestamp NULL, -- 为空时不过期
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (scope, scope_key, pid)
+);
+CREATE INDEX idx_post_pin_pid ON post_pin (pid);
+CREATE INDEX idx_post_pin_expire ON post_pin (expire_time) WHERE expire_time IS NOT NULL;
+
+-- 版块，post_permission为0时所有用户可以发帖，为1时只有版块的版主可以发帖
+CREATE TABLE board (
+    id bigserial not null,
+    name varchar(32) NOT NULL,
+    slug varchar(32) NOT NULL, -- 用于url，小写字母、数字与连字符
+    description varchar(500) NOT NULL DEFAULT '',
+    post_permission smallint NOT NULL DEFAULT 0,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+    update_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (id),
+    unique (slug)
+);
+
+-- 版块的版主，只能审核、置顶与恢复本版块的帖子，post_moderator中的版主可以管理所有版块
+CREATE TABLE board_moderator (
+    board_id bigint NOT NULL,
+    uid bigint NOT NULL,
+    create_time timestamp NOT NULL DEFAULT current_timestamp,
+
+    primary key (board_id, uid)
+);
+CREATE INDEX idx_board_moderator_uid ON board_moderator (uid);
+
+-- 分布式锁写入的栅栏令牌，name为锁的key，只接受不小于已写入令牌的写入，防止锁过期后的旧持有者覆盖新持有者的结果
+CREATE TABLE lock_fence (
+    name varchar(128) NOT NULL,
+    fence bigint NOT NULL,
+
+    primary key (name)
+);
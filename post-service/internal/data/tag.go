@@ -0,0 +1,219 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	tagTrendTTL      = 8 * 24 * time.Hour // 保留一周以上的每日热度
+	tagTrendDecay    = 0.5                // 每早一天热度权重减半
+	tagTrendUnionTTL = time.Minute        // 合并结果每次请求都会重新计算，只需要在读取完成前存在
+)
+
+var tagLikeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ResolveTagAliases 返回names中属于别名的项与其标准名
+func (repo *PostRepo) ResolveTagAliases(ctx context.Context, names []string) (map[string]string, error) {
+	sqlStr := `
+	select alias, name from tag_alias
+	where alias = any($1)`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, names)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		aliases := make(map[string]string)
+		for rows.Next() {
+			var alias, name string
+			if err := rows.Scan(&alias, &name); err != nil {
+				return nil, err
+			}
+			aliases[alias] = name
+		}
+		return aliases, rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(map[string]string), nil
+}
+
+// UpdateTagCounts 新增的标签帖子数加一，目录中不存在时自动创建；移除的标签帖子数减一
+func (repo *PostRepo) UpdateTagCounts(ctx context.Context, added, removed []string) error {
+	_, err := pgBreaker.Execute(func() (interface{}, error) {
+		batch := &pgx.Batch{}
+		if len(added) != 0 {
+			batch.Queue(`
+			insert into tag_info(name, post_count)
+			select unnest($1::varchar(64)[]), 1
+			on conflict (name) do update set post_count = tag_info.post_count + 1`, added)
+		}
+		if len(removed) != 0 {
+			batch.Queue(`
+			update tag_info set post_count = greatest(post_count - 1, 0)
+			where name = any($1)`, removed)
+		}
+		return nil, repo.data.PgxCli.SendBatch(ctx, batch).Close()
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "UpdateTagCounts/SendBatch failed",
+			"err", err,
+		)
+	}
+	return err
+}
+
+func (repo *PostRepo) IncrTagTrend(ctx context.Context, day time.Time, tags []string, score float64) error {
+	key := GetTagTrendKey(day)
+	pipe := repo.data.Rcli.Pipeline()
+	for _, tag := range tags {
+		pipe.ZIncrBy(ctx, key, score, tag)
+	}
+	pipe.Expire(ctx, key, tagTrendTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (repo *PostRepo) ListPostPreviewByTag(ctx context.Context, tag string, page, pageSize int64) ([]*model.PostPreview, error) {
+	// tags上有gin索引，使用@>才能命中
+	sqlStr := `
+	select id, pid, create_time, update_time, title, content, author, status, score, tags, view, "like", comment_count, favorite_count, board_id
+	from post_info
+	where is_del = 0 and status in (1, 4) and tags @> array[$1]::varchar(64)[]
+	order by update_time desc, pid desc
+	limit $2 offset $3`
+	return repo.queryPostPreviews(ctx, sqlStr, tag, pageSize, page*pageSize)
+}
+
+func (repo *PostRepo) ListTags(ctx context.Context, prefix string, page, pageSize int64) ([]*model.Tag, error) {
+	sqlStr := `
+	select name, post_count from tag_info
+	where post_count > 0 and name like $1
+	order by post_count desc, name
+	limit $2 offset $3`
+	res, err := pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, sqlStr, tagLikeEscaper.Replace(prefix)+"%", pageSize, page*pageSize)
+		if err != nil {
+			return nil, err
+		}
+		return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.Tag, error) {
+			tag := new(model.Tag)
+			err := row.Scan(&tag.Name, &tag.PostCount)
+			return tag, err
+		})
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListTags/Query failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return res.([]*model.Tag), nil
+}
+
+// ListTrendingTags 按衰减权重合并最近days天的热度排行，并补充标签的帖子数
+// 合并结果写入短期存在的key，由redis排序后只取前limit个
+func (repo *PostRepo) ListTrendingTags(ctx context.Context, now time.Time, days, limit int64) ([]*model.Tag, error) {
+	keys := make([]string, 0, days)
+	weights := make([]float64, 0, days)
+	for i := range days {
+		keys = append(keys, GetTagTrendKey(now.AddDate(0, 0, -int(i))))
+		weights = append(weights, math.Pow(tagTrendDecay, float64(i)))
+	}
+	dest := fmt.Sprintf(common.RKeyTagTrendUnion, days)
+	res, err := redisBreaker.Execute(func() (interface{}, error) {
+		var top *redis.ZSliceCmd
+		_, err := repo.data.Rcli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZUnionStore(ctx, dest, &redis.ZStore{
+				Keys:      keys,
+				Weights:   weights,
+				Aggregate: "SUM",
+			})
+			pipe.Expire(ctx, dest, tagTrendUnionTTL)
+			top = pipe.ZRevRangeByScoreWithScores(ctx, dest, &redis.ZRangeBy{
+				Min:   "(0",
+				Max:   "+inf",
+				Count: limit,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return top.Val(), nil
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "ListTrendingTags/ZUnionStore failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	members := res.([]redis.Z)
+
+	tags := make([]*model.Tag, 0, len(members))
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		name, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		tags = append(tags, &model.Tag{Name: name, Score: member.Score})
+		names = append(names, name)
+	}
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	res, err = pgBreaker.Execute(func() (interface{}, error) {
+		rows, err := repo.data.PgxCli.Query(ctx, `
+		select name, post_count from tag_info
+		where name = any($1)`, names)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		counts := make(map[string]int64, len(names))
+		for rows.Next() {
+			var (
+				name  string
+				count int64
+			)
+			if err := rows.Scan(&name, &count); err != nil {
+				return nil, err
+			}
+			counts[name] = count
+		}
+		return counts, rows.Err()
+	})
+	if err != nil {
+		// 帖子数只是补充信息，查询失败时仍然返回热门标签
+		repo.log.Errorw(
+			"[repo]", "ListTrendingTags/Query failed",
+			"err", err,
+		)
+		return tags, nil
+	}
+	counts := res.(map[string]int64)
+	for _, tag := range tags {
+		tag.PostCount = counts[tag.Name]
+	}
+	return tags, nil
+}
+
+func GetTagTrendKey(day time.Time) string {
+	return fmt.Sprintf(common.RKeyTagTrend, day.Format("20060102"))
+}
@@ -0,0 +1,219 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"post-service/third_party/redislock"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 访客第一次出现在当前窗口时累加待写入的浏览数，HyperLogLog在第一次写入时设置过期时间
+var recordViewScript = redis.NewScript(`
+local added = redis.call('PFADD', KEYS[1], ARGV[1])
+if redis.call('TTL', KEYS[1]) < 0 then
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+if added == 1 then
+	redis.call('HINCRBY', KEYS[2], ARGV[3], 1)
+end
+return added`)
+
+// RecordView 记录一次浏览，同一访客在同一时间窗口内只计一次，返回是否计数
+// HyperLogLog存在少量误判，极少数新访客可能不被计数
+func (repo *PostRepo) RecordView(ctx context.Context, pid int64, visitor string, window time.Duration) (bool, error) {
+	res, err := redisBreaker.Execute(func() (interface{}, error) {
+		key := fmt.Sprintf(common.RKeyPostViewDedupe, pid, time.Now().Unix()/int64(window.Seconds()))
+		return recordViewScript.Run(ctx, repo.data.Rcli,
+			[]string{key, common.RKeyPostViewPending},
+			visitor, int64(window.Seconds()), pid,
+		).Int()
+	})
+	if err != nil {
+		repo.log.Errorw(
+			"[repo]", "RecordView/Run failed",
+			"err", err,
+			"pid", pid,
+		)
+		return false, err
+	}
+	return res.(int) == 1, nil
+}
+
+// mergePendingViews 把尚未写入pg的浏览数加到帖子上，返回副本，避免修改singleflight共享的结果
+// 读取失败时返回原始数据
+func (repo *PostRepo) mergePendingViews(ctx context.Context, posts ...*model.Post) []*model.Post {
+	merged := make([]*model.Post, 0, len(posts))
+	fields := make([]string, 0, len(posts))
+	for _, post := range posts {
+		p := *post
+		merged = append(merged, &p)
+		fields = append(fields, strconv.FormatInt(post.Pid, 10))
+	}
+	if len(fields) == 0 {
+		return merged
+	}
+
+	pipe := repo.data.Rcli.Pipeline()
+	pending := pipe.HMGet(ctx, common.RKeyPostViewPending, fields...)
+	flushing := pipe.HMGet(ctx, common.RKeyPostViewFlush, fields...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		repo.log.Errorw(
+			"[repo]", "mergePendingViews/Exec failed",
+			"err", err,
+		)
+		return merged
+	}
+	for _, vals := range [][]interface{}{pending.Val(), flushing.Val()} {
+		for i, val := range vals {
+			str, ok := val.(string)
+			if !ok {
+				continue
+			}
+			if delta, err := strconv.ParseInt(str, 10, 64); err == nil {
+				merged[i].View += delta
+			}
+		}
+	}
+	return merged
+}
+
+func (repo *PostRepo) mergePendingViewsMap(ctx context.Context, posts map[int64]*model.Post) map[int64]*model.Post {
+	list := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		list = append(list, post)
+	}
+	merged := make(map[int64]*model.Post, len(posts))
+	for _, post := range repo.mergePendingViews(ctx, list...) {
+		merged[post.Pid] = post
+	}
+	return merged
+}
+
+// 上次未完成的数据还在时不改名，否则会覆盖尚未写入pg的增量
+// 每次改名生成新的批次号，批次号取redis的时间，重试同一批数据时批次号不变
+var swapPendingViewsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[2]) == 0 then
+	if redis.call('EXISTS', KEYS[1]) == 0 then
+		return false
+	end
+	redis.call('RENAME', KEYS[1], KEYS[2])
+	redis.call('DEL', KEYS[3])
+end
+local batch = redis.call('GET', KEYS[3])
+if not batch then
+	local t = redis.call('TIME')
+	batch = t[1] .. string.format('%06d', t[2])
+	redis.call('SET', KEYS[3], batch)
+end
+return batch`)
+
+// FlushPendingViews 将redis中累计的浏览数分批写入pg，返回写入的帖子数
+// 待写入的数据先整体改名，之后的浏览会累加到新的key中
+// pg中记录每个帖子最后写入的批次号，同一批数据重复写入时不会重复累加，中途失败时下次重试整批即可
+// 任务租约已被其他实例接手时返回redislock.ErrFenceStale
+func (repo *PostRepo) FlushPendingViews(ctx context.Context, batchSize int64) (int, error) {
+	batch, err := swapPendingViewsScript.Run(ctx, repo.data.Rcli,
+		[]string{common.RKeyPostViewPending, common.RKeyPostViewFlush, common.RKeyPostViewBatch},
+	).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	sqlStr := `
+	update post_info
+	set view = view + v.delta, view_batch = $3
+	from unnest($1::bigint[], $2::bigint[]) as v(pid, delta)
+	where post_info.pid = v.pid and post_info.is_del = 0 and post_info.view_batch < $3`
+	total := 0
+	var cursor uint64
+	for {
+		kvs, next, err := repo.data.Rcli.HScan(ctx, common.RKeyPostViewFlush, cursor, "", batchSize).Result()
+		if err != nil {
+			return total, err
+		}
+		fields := make([]string, 0, len(kvs)/2)
+		pids := make([]int64, 0, len(kvs)/2)
+		deltas := make([]int64, 0, len(kvs)/2)
+		for i := 0; i+1 < len(kvs); i += 2 {
+			fields = append(fields, kvs[i])
+			pid, err1 := strconv.ParseInt(kvs[i], 10, 64)
+			delta, err2 := strconv.ParseInt(kvs[i+1], 10, 64)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			pids = append(pids, pid)
+			deltas = append(deltas, delta)
+		}
+
+		if len(fields) != 0 {
+			var stale bool
+			if _, err := pgBreaker.Execute(func() (interface{}, error) {
+				tx, err := repo.data.PgxCli.Begin(ctx)
+				if err != nil {
+					return nil, err
+				}
+				defer tx.Rollback(ctx)
+
+				ok, err := checkFence(ctx, tx)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					stale = true
+					return nil, nil
+				}
+				if _, err := tx.Exec(ctx, sqlStr, pids, deltas, batch); err != nil {
+					return nil, err
+				}
+				return nil, tx.Commit(ctx)
+			}); err != nil {
+				repo.log.Errorw(
+					"[repo]", "FlushPendingViews/Exec failed",
+					"err", err,
+					"batch", batch,
+				)
+				return total, err
+			}
+			// 租约已被其他实例接手，留给新持有者写入
+			if stale {
+				return total, redislock.ErrFenceStale
+			}
+			if err := repo.data.Rcli.HDel(ctx, common.RKeyPostViewFlush, fields...).Err(); err != nil {
+				repo.log.Errorw(
+					"[repo]", "FlushPendingViews/HDel failed",
+					"err", err,
+				)
+				return total, err
+			}
+			// 缓存中的浏览数已经过期，删除后重新从pg读取
+			keys := make([]string, 0, len(pids))
+			for _, pid := range pids {
+				keys = append(keys, GetPostInfoKey(pid))
+			}
+			if len(keys) != 0 {
+				if err := repo.data.Rcli.Del(ctx, keys...).Err(); err != nil {
+					repo.log.Errorw(
+						"[repo]", "FlushPendingViews/Del failed",
+						"err", err,
+					)
+				}
+			}
+			total += len(pids)
+		}
+
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+	return total, nil
+}
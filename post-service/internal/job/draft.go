@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	draftPublishInterval  = 10 * time.Second
+	draftPublishBatchSize = 100
+)
+
+// DraftPublishJob 定时发布到时间的草稿，多个实例通过租约保证同一时间只有一个在执行
+// 即使租约失效导致并发执行，草稿的删除与帖子的写入在同一事务中，每个草稿也只会发布一次，旧持有者的写入由栅栏令牌拒绝
+func (j *JobRepo) DraftPublishJob(ctx context.Context) {
+	j.log.Infof("Draft publish job started")
+
+	ticker := time.NewTicker(draftPublishInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		j.withLease(ctx, "draft_publish", func(ctx context.Context) {
+			n, err := j.uc.PublishDueDrafts(ctx, time.Now(), draftPublishBatchSize)
+			if err != nil {
+				j.log.Errorw(
+					"[job]", "DraftPublishJob/PublishDueDrafts failed",
+					"err", err,
+					"published", n,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+// FilterReloadJob 定时检查敏感词词库文件，有变化时重新加载
+// 词库在每个实例的内存中，所有实例都需要执行，不使用租约
+func (j *JobRepo) FilterReloadJob(ctx context.Context) {
+	j.log.Infof("Filter reload job started")
+
+	ticker := time.NewTicker(j.filter.Interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := j.filter.Reload(); err != nil {
+			j.log.Errorw(
+				"[job]", "FilterReloadJob/Reload failed",
+				"err", err,
+			)
+		}
+	}
+}
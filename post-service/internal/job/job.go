@@ -1,9 +1,11 @@
 package job
 
 import (
+	"post-service/internal/biz"
 	"post-service/internal/common"
 	"post-service/internal/conf"
 	"post-service/internal/data"
+	"post-service/third_party/redislock"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -15,11 +17,16 @@ var ProviderSet = wire.NewSet(NewJobRepo)
 type JobRepo struct {
 	repo   *data.PostRepo
 	data   *data.Data
+	ranker *biz.HotRanker
+	uc     *biz.PostUsecase
+	filter *biz.ContentFilter
+	locker *redislock.Client
 	kafkaR map[string]*kafka.Reader
+	likeR  *kafka.Reader // 点赞事件使用消费组，处理完成后手动提交offset
 	log    *log.Helper
 }
 
-func NewJobRepo(c *conf.Data, repo *data.PostRepo, data *data.Data, logger log.Logger) *JobRepo {
+func NewJobRepo(c *conf.Data, repo *data.PostRepo, data *data.Data, ranker *biz.HotRanker, uc *biz.PostUsecase, filter *biz.ContentFilter, locker *redislock.Client, logger log.Logger) *JobRepo {
 	partitions, err := data.KafkaConn.ReadPartitions()
 	if err != nil {
 		panic(err)
@@ -60,10 +67,35 @@ func NewJobRepo(c *conf.Data, repo *data.PostRepo, data *data.Data, logger log.L
 			Topic:   common.TopicPostCacheDel,
 		})
 	}
+	if _, ok := m[common.TopicPostLike]; !ok {
+		data.KafkaConn.CreateTopics(
+			kafka.TopicConfig{
+				Topic:             common.TopicPostLike,
+				NumPartitions:     3,
+				ReplicationFactor: 1,
+				ConfigEntries: []kafka.ConfigEntry{
+					{
+						ConfigName:  "retention.ms",
+						ConfigValue: "259200000", // 3天，短于已处理事件id的保留时间
+					},
+				},
+			},
+		)
+	}
+	likeR := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: c.Kafka.Addrs,
+		GroupID: common.GroupPostLike,
+		Topic:   common.TopicPostLike,
+	})
 	return &JobRepo{
 		repo:   repo,
 		data:   data,
+		ranker: ranker,
+		uc:     uc,
+		filter: filter,
+		locker: locker,
 		kafkaR: kafkaR,
+		likeR:  likeR,
 		log:    log.NewHelper(logger),
 	}
 }
@@ -0,0 +1,48 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"post-service/internal/common"
+	"post-service/third_party/redislock"
+	"time"
+)
+
+// jobLeaseTTL 持有期间会自动续期，只决定实例宕机后其他实例多久能接手
+const jobLeaseTTL = 15 * time.Second
+
+// withLease 多个实例中只有获取到租约的实例执行fn，返回是否执行
+// 租约丢失时fn的ctx会被取消，fn需要在ctx取消后尽快返回
+// fn的ctx携带租约的栅栏令牌，浏览数写入与草稿发布在事务中校验令牌，拒绝租约过期后旧持有者的写入
+// 其他任务不校验令牌，写入需要自身幂等
+func (j *JobRepo) withLease(ctx context.Context, name string, fn func(ctx context.Context)) bool {
+	key := fmt.Sprintf(common.RKeyJobLease, name)
+	lock, err := j.locker.TryLock(ctx, key, jobLeaseTTL)
+	if err != nil {
+		if !errors.Is(err, redislock.ErrNotAcquired) {
+			j.log.Errorw(
+				"[job]", "withLease/TryLock failed",
+				"err", err,
+				"name", name,
+			)
+		}
+		return false
+	}
+
+	leaseCtx, cancel := context.WithCancel(lock.Context())
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+	defer cancel()
+	fn(lock.WithFence(leaseCtx))
+
+	if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+		j.log.Errorw(
+			"[job]", "withLease/Unlock failed",
+			"err", err,
+			"name", name,
+			"fence", lock.Fence(),
+		)
+	}
+	return true
+}
@@ -0,0 +1,251 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"post-service/internal/common"
+	"post-service/internal/model"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	likeBatchSize     = 200
+	likeBatchWait     = 500 * time.Millisecond // 凑满一批的最长等待时间
+	likeRetryInterval = time.Second
+
+	likeReconcileInterval = time.Hour
+	likeReconcileGrace    = time.Minute // 两次确认之间的间隔，等待已写入点赞记录但尚未发送的事件
+	likeReconcilePageSize = 500
+	likeEventKeep         = 7 * 24 * time.Hour // 需要长于点赞事件在kafka中的保留时间
+)
+
+// LikeEventJob 消费点赞事件，批量累加点赞数并重新计算热度，写入pg后才提交offset
+func (j *JobRepo) LikeEventJob(ctx context.Context) {
+	j.log.Infof("Like event job started")
+
+	for {
+		msgs, err := j.fetchLikeBatch(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			j.log.Errorw(
+				"[job]", "LikeEventJob/FetchMessage failed",
+				"err", err,
+			)
+			time.Sleep(likeRetryInterval)
+			continue
+		}
+
+		events := make([]*model.LikeEvent, 0, len(msgs))
+		for _, msg := range msgs {
+			event := new(model.LikeEvent)
+			if err := json.Unmarshal(msg.Value, event); err != nil {
+				// 无法解析的消息直接跳过
+				j.log.Errorw(
+					"[job]", "LikeEventJob/Unmarshal failed",
+					"err", err,
+					"value", string(msg.Value),
+				)
+				continue
+			}
+			events = append(events, event)
+		}
+
+		// 重复写入会被事件id去重，失败时一直重试同一批，保证offset之前的事件都已写入
+		var stats []*model.PostStat
+		for {
+			stats, err = j.repo.ApplyLikeEvents(ctx, events)
+			if err == nil {
+				break
+			}
+			j.log.Errorw(
+				"[job]", "LikeEventJob/ApplyLikeEvents failed",
+				"err", err,
+				"count", len(events),
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(likeRetryInterval):
+			}
+		}
+		j.rescoreStats(ctx, stats)
+
+		if err := j.likeR.CommitMessages(ctx, msgs...); err != nil {
+			j.log.Errorw(
+				"[job]", "LikeEventJob/CommitMessages failed",
+				"err", err,
+			)
+		}
+	}
+}
+
+// fetchLikeBatch 阻塞等待第一条消息，之后在likeBatchWait内尽量凑满一批
+func (j *JobRepo) fetchLikeBatch(ctx context.Context) ([]kafka.Message, error) {
+	msg, err := j.likeR.FetchMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := []kafka.Message{msg}
+
+	waitCtx, cancel := context.WithTimeout(ctx, likeBatchWait)
+	defer cancel()
+	for len(msgs) < likeBatchSize {
+		msg, err := j.likeR.FetchMessage(waitCtx)
+		if err != nil {
+			break
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// rescoreStats 点赞数变化后立即更新热度
+func (j *JobRepo) rescoreStats(ctx context.Context, stats []*model.PostStat) {
+	if len(stats) == 0 {
+		return
+	}
+	now := time.Now()
+	scores := make(map[int64]int64, len(stats))
+	for _, stat := range stats {
+		scores[stat.Pid] = j.ranker.Score(stat.Like, stat.CommentCount, stat.View, stat.CreateTime, now)
+	}
+	if err := j.repo.UpdateHotScores(ctx, scores); err != nil {
+		j.log.Errorw(
+			"[job]", "LikeEventJob/UpdateHotScores failed",
+			"err", err,
+		)
+	}
+}
+
+// LikeReconcileJob 定时以post_like中的点赞记录为准修正帖子的点赞数，并清理过期的事件id，多个实例中同一时间只有一个在执行
+func (j *JobRepo) LikeReconcileJob(ctx context.Context) {
+	j.log.Infof("Like reconcile job started")
+	j.migrateLikeSets(ctx)
+
+	ticker := time.NewTicker(likeReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		j.withLease(ctx, "like_reconcile", func(ctx context.Context) {
+			j.reconcileLikes(ctx)
+			if _, err := j.repo.CleanLikeEvents(ctx, time.Now().Add(-likeEventKeep)); err != nil {
+				j.log.Errorw(
+					"[job]", "LikeReconcileJob/CleanLikeEvents failed",
+					"err", err,
+				)
+			}
+		})
+	}
+}
+
+// reconcileLikes 找出点赞数不一致的帖子，等待一段时间后再次确认
+// 两次读到的点赞数与点赞记录数都没有变化，且第二次读取后消费组没有积压时才修正
+// 积压为0说明第二次读取前写入的点赞记录对应的事件都已累加，之后才累加的事件会改变点赞数，修正时按点赞数比较后放弃
+func (j *JobRepo) reconcileLikes(ctx context.Context) {
+	suspects := make(map[int64]*model.LikeCount)
+	var afterPid int64
+	for {
+		counts, err := j.repo.ListLikeCounts(ctx, afterPid, likeReconcilePageSize)
+		if err != nil {
+			j.log.Errorw(
+				"[job]", "LikeReconcileJob/ListLikeCounts failed",
+				"err", err,
+			)
+			return
+		}
+		for _, count := range counts {
+			if likeDrifted(count) {
+				suspects[count.Pid] = count
+			}
+		}
+		if len(counts) < likeReconcilePageSize {
+			break
+		}
+		afterPid = counts[len(counts)-1].Pid
+	}
+	if len(suspects) == 0 {
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(likeReconcileGrace):
+	}
+	pids := make([]int64, 0, len(suspects))
+	for pid := range suspects {
+		pids = append(pids, pid)
+	}
+	counts, err := j.repo.GetLikeCounts(ctx, pids)
+	if err != nil {
+		j.log.Errorw(
+			"[job]", "LikeReconcileJob/GetLikeCounts failed",
+			"err", err,
+		)
+		return
+	}
+	// 消费积压时在途的事件还会累加到点赞数上，按点赞记录修正会重复计数
+	lag, err := j.repo.LikeEventLag(ctx)
+	if err != nil {
+		return
+	}
+	if lag != 0 {
+		j.log.Infow(
+			"[job]", "LikeReconcileJob/skipped with consumer lag",
+			"lag", lag,
+			"suspects", len(suspects),
+		)
+		return
+	}
+	for _, count := range counts {
+		prev := suspects[count.Pid]
+		if !likeDrifted(count) || prev.PgLike != count.PgLike || prev.Actual != count.Actual {
+			continue
+		}
+		ok, err := j.repo.RepairLikeCount(ctx, count)
+		if err != nil {
+			continue
+		}
+		if ok {
+			j.log.Warnw(
+				"[job]", "LikeReconcileJob/like count repaired",
+				"pid", count.Pid,
+				"pgLike", count.PgLike,
+				"actual", count.Actual,
+			)
+		}
+	}
+}
+
+func likeDrifted(count *model.LikeCount) bool {
+	return count.PgLike != count.Actual
+}
+
+// migrateLikeSets 把post_like上线前只存在于redis集合中的点赞导入pg，多个实例中只有一个会执行
+func (j *JobRepo) migrateLikeSets(ctx context.Context) {
+	ok, err := j.data.Rcli.SetNX(ctx, common.RKeyPostLikeMigrated, time.Now().Unix(), 0).Result()
+	if err != nil || !ok {
+		return
+	}
+	n, err := j.repo.MigrateLikeSets(ctx)
+	if err != nil {
+		j.log.Errorw(
+			"[job]", "LikeReconcileJob/MigrateLikeSets failed",
+			"err", err,
+			"migrated", n,
+		)
+		// 允许下次启动时重试，重复导入的记录会被忽略
+		j.data.Rcli.Del(ctx, common.RKeyPostLikeMigrated)
+		return
+	}
+	j.log.Infof("Migrated %d likes from redis sets", n)
+}
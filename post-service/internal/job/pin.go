@@ -0,0 +1,31 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+const pinExpireInterval = time.Minute
+
+// PinExpireJob 定时清理过期的置顶，列表查询时已经忽略过期的置顶，这里只负责恢复帖子状态
+func (j *JobRepo) PinExpireJob(ctx context.Context) {
+	j.log.Infof("Pin expire job started")
+
+	ticker := time.NewTicker(pinExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		j.withLease(ctx, "pin_expire", func(ctx context.Context) {
+			if _, err := j.uc.ExpirePins(ctx, time.Now()); err != nil {
+				j.log.Errorw(
+					"[job]", "PinExpireJob/ExpirePins failed",
+					"err", err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"post-service/internal/biz"
+	"time"
+)
+
+// RankJob 定时重新计算近期帖子的热度，并重建日榜、周榜与总榜，多个实例中同一时间只有一个在执行
+func (j *JobRepo) RankJob(ctx context.Context) {
+	j.log.Infof("Rank job started")
+
+	ticker := time.NewTicker(j.ranker.Interval())
+	defer ticker.Stop()
+	for {
+		j.withLease(ctx, "rank", func(ctx context.Context) {
+			j.rescore(ctx)
+			j.rebuildLeaderboards(ctx)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *JobRepo) rescore(ctx context.Context) {
+	now := time.Now()
+	// 多算一个间隔，让刚超出时间段的帖子也能得到最后一次衰减
+	stats, err := j.repo.ListRecentPostStats(ctx, now.Add(-j.ranker.Window()-j.ranker.Interval()))
+	if err != nil {
+		j.log.Errorw(
+			"[job]", "RankJob/ListRecentPostStats failed",
+			"err", err,
+		)
+		return
+	}
+	scores := make(map[int64]int64, len(stats))
+	for _, stat := range stats {
+		scores[stat.Pid] = j.ranker.Score(stat.Like, stat.CommentCount, stat.View, stat.CreateTime, now)
+	}
+	if err := j.repo.UpdateHotScores(ctx, scores); err != nil {
+		j.log.Errorw(
+			"[job]", "RankJob/UpdateHotScores failed",
+			"err", err,
+			"count", len(scores),
+		)
+	}
+}
+
+func (j *JobRepo) rebuildLeaderboards(ctx context.Context) {
+	now := time.Now()
+	for _, period := range []string{biz.PeriodDay, biz.PeriodWeek, biz.PeriodAll} {
+		since, err := j.ranker.Since(period, now)
+		if err != nil {
+			continue
+		}
+		if err := j.repo.RebuildLeaderboard(ctx, period, since, j.ranker.Weights(), biz.LeaderboardSize); err != nil {
+			j.log.Errorw(
+				"[job]", "RankJob/RebuildLeaderboard failed",
+				"err", err,
+				"period", period,
+			)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	searchBackfillBatchSize = 200
+	searchBackfillRetry     = time.Minute
+)
+
+// SearchBackfillJob 启动时补齐旧帖子的全文检索列，全部补齐后退出
+// 没有获取到租约时说明其他实例正在补齐，稍后再检查
+func (j *JobRepo) SearchBackfillJob(ctx context.Context) {
+	j.log.Infof("Search backfill job started")
+
+	for {
+		done := false
+		j.withLease(ctx, "search_backfill", func(ctx context.Context) {
+			total := 0
+			for ctx.Err() == nil {
+				n, err := j.repo.BackfillSearch(ctx, searchBackfillBatchSize)
+				if err != nil {
+					j.log.Errorw(
+						"[job]", "SearchBackfillJob/BackfillSearch failed",
+						"err", err,
+						"backfilled", total,
+					)
+					return
+				}
+				total += n
+				if n < searchBackfillBatchSize {
+					done = true
+					j.log.Infof("Search backfill finished: %d posts", total)
+					return
+				}
+			}
+		})
+		if done {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(searchBackfillRetry):
+		}
+	}
+}
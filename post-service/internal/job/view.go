@@ -0,0 +1,37 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	viewFlushInterval  = 10 * time.Second
+	viewFlushBatchSize = 500
+)
+
+// ViewFlushJob 定时将redis中累计的浏览数写入pg，多个实例中同一时间只有一个在执行
+func (j *JobRepo) ViewFlushJob(ctx context.Context) {
+	j.log.Infof("View flush job started")
+
+	ticker := time.NewTicker(viewFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// 多个实例同时写入会重复累加同一批浏览数
+		j.withLease(ctx, "view_flush", func(ctx context.Context) {
+			n, err := j.repo.FlushPendingViews(ctx, viewFlushBatchSize)
+			if err != nil {
+				j.log.Errorw(
+					"[job]", "ViewFlushJob/FlushPendingViews failed",
+					"err", err,
+					"flushed", n,
+				)
+			}
+		})
+	}
+}
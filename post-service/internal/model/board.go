@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// 版块的发帖权限
+const (
+	BoardPostAll        int32 = 0 // 所有用户
+	BoardPostModerators int32 = 1 // 只有版块的版主
+)
+
+// Board 版块，每个帖子属于一个版块，Moderators为版块的版主uid
+type Board struct {
+	Id             int64     `json:"id" db:"id"`
+	Name           string    `json:"name" db:"name"`
+	Slug           string    `json:"slug" db:"slug"`
+	Description    string    `json:"description" db:"description"`
+	PostPermission int32     `json:"post_permission" db:"post_permission"`
+	Moderators     []int64   `json:"moderators" db:"moderators"`
+	CreateTime     time.Time `json:"create_time" db:"create_time"`
+	UpdateTime     time.Time `json:"update_time" db:"update_time"`
+}
+
+// UpdateBoardParam 为nil的字段不修改，slug创建后不能修改
+type UpdateBoardParam struct {
+	Id             int64   `json:"id"`
+	Name           *string `json:"name"`
+	Description    *string `json:"description"`
+	PostPermission *int32  `json:"post_permission"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type Comment struct {
+	Id         int64     `json:"id" db:"id"`
+	Cid        int64     `json:"cid" db:"cid"`
+	Pid        int64     `json:"pid" db:"pid"`
+	CreateTime time.Time `json:"create_time" db:"create_time"`
+	Uid        int64     `json:"uid" db:"uid"`
+	Author     string    `json:"author" db:"author"`
+	Content    string    `json:"content" db:"content"`
+	RootId     int64     `json:"root_id" db:"root_id"`
+	ReplyToUid int64     `json:"reply_to_uid" db:"reply_to_uid"`
+	Like       int64     `json:"like" db:"like"`
+	ReplyCount int64     `json:"reply_count" db:"reply_count"`
+	Status     int32     `json:"status" db:"status"` // 1正常，2被举报隐藏
+}
+
+type CreateCommentParam struct {
+	Pid     int64  `json:"pid"`
+	Content string `json:"content"`
+	ReplyTo int64  `json:"reply_to"` // 回复的评论cid，0表示一级评论
+}
+
+func (c *Comment) ScanArgs() []any {
+	return []any{
+		&c.Id, &c.Cid, &c.Pid, &c.CreateTime, &c.Uid,
+		&c.Author, &c.Content, &c.RootId, &c.ReplyToUid,
+		&c.Like, &c.ReplyCount, &c.Status,
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Draft 帖子草稿，PublishAt不为nil时到时间后自动发布
+type Draft struct {
+	Did        int64      `json:"did" db:"did"`
+	Uid        int64      `json:"uid" db:"uid"`
+	Title      string     `json:"title" db:"title"`
+	Content    string     `json:"content" db:"content"`
+	Tags       []string   `json:"tags" db:"tags"`
+	BoardId    int64      `json:"board_id" db:"board_id"` // 为0时发布前需要选择版块
+	PublishAt  *time.Time `json:"publish_at" db:"publish_at"`
+	CreateTime time.Time  `json:"create_time" db:"create_time"`
+	UpdateTime time.Time  `json:"update_time" db:"update_time"`
+}
+
+type SaveDraftParam struct {
+	Did       int64      `json:"did"` // 为0时新建草稿
+	Uid       int64      `json:"uid"`
+	Title     string     `json:"title"`
+	Content   string     `json:"content"`
+	Tags      []string   `json:"tags"`
+	BoardId   int64      `json:"board_id"`
+	PublishAt *time.Time `json:"publish_at"` // 为nil时取消定时发布
+}
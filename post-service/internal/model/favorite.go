@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Favorite 收藏记录，CollectionId为0表示未分类
+// 帖子被删除后收藏仍然保留，Post为nil且Removed为true
+type Favorite struct {
+	Uid          int64     `json:"uid" db:"uid"`
+	Pid          int64     `json:"pid" db:"pid"`
+	CollectionId int64     `json:"collection_id" db:"collection_id"`
+	CreateTime   time.Time `json:"create_time" db:"create_time"`
+
+	Post    *PostPreview `json:"post" db:"-"`
+	Removed bool         `json:"removed" db:"-"`
+}
+
+// Collection 用户自定义的收藏夹
+type Collection struct {
+	CollectionId  int64     `json:"collection_id" db:"collection_id"`
+	Uid           int64     `json:"uid" db:"uid"`
+	Name          string    `json:"name" db:"name"`
+	CreateTime    time.Time `json:"create_time" db:"create_time"`
+	FavoriteCount int64     `json:"favorite_count" db:"favorite_count"`
+}
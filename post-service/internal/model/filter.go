@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// 内容类型，用于敏感词命中记录与举报
+const (
+	TargetPost    = "post"
+	TargetComment = "comment"
+)
+
+// FilterHit 命中的敏感词及其分类
+type FilterHit struct {
+	Category string `json:"category"`
+	Term     string `json:"term"`
+}
+
+// FilterLog 一次命中敏感词的记录，供版主查看，被拒绝发布的内容TargetId为0
+type FilterLog struct {
+	Id         int64     `json:"id" db:"id"`
+	TargetType string    `json:"target_type" db:"target_type"`
+	TargetId   int64     `json:"target_id" db:"target_id"`
+	Uid        int64     `json:"uid" db:"uid"`
+	Action     string    `json:"action" db:"action"`
+	Categories []string  `json:"categories" db:"categories"`
+	Terms      []string  `json:"terms" db:"terms"`
+	CreateTime time.Time `json:"create_time" db:"create_time"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// 通知类型
+const (
+	NotifyPostApproved = "post_approved"
+	NotifyPostRejected = "post_rejected"
+)
+
+// Notification 发给用户的站内通知，Content为附加说明，如驳回原因
+type Notification struct {
+	Id         int64     `json:"id" db:"id"`
+	Uid        int64     `json:"uid" db:"uid"`
+	Type       string    `json:"type" db:"type"`
+	Pid        int64     `json:"pid" db:"pid"`
+	Content    string    `json:"content" db:"content"`
+	IsRead     bool      `json:"is_read" db:"is_read"`
+	CreateTime time.Time `json:"create_time" db:"create_time"`
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/lib/pq"
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
 
 type CreatePostParam struct {
 	Pid     int64    `json:"pid" db:"pid"`
@@ -10,24 +14,38 @@ type CreatePostParam struct {
 	Uid     int64    `json:"uid" db:"uid"`
 	Score   int64    `json:"score" db:"score"`
 	Tags    []string `json:"tags" db:"tags"`
+	Status  int32    `json:"status" db:"status"`
+	BoardId int64    `json:"board_id" db:"board_id"`
 }
 type UpdatePostParam struct {
 	Pid     int64    `json:"pid" db:"pid"`
 	Title   *string  `json:"title" db:"title"`
 	Content *string  `json:"content" db:"content"`
 	Status  *int32   `json:"status" db:"status"`
-	Score   *int64   `json:"score" db:"score"`
 	Tags    []string `json:"tags" db:"tags"`
-	Like    *int64   `json:"like" db:"like"`
+	Editor  int64    `json:"editor" db:"-"`  // 编辑者uid，记录在编辑历史中
+	Version int64    `json:"version" db:"-"` // 预期的版本号，与数据库中不一致时更新失败
+	// Moderate 修改的内容命中需要审核的词，可见的帖子重新进入审核
+	Moderate bool `json:"moderate" db:"-"`
 }
 
 func (p *CreatePostParam) ToArgs() []interface{} {
 	return []interface{}{
-		p.Pid, p.Title, p.Content, p.Author, p.Uid, p.Score, pq.Array(p.Tags),
+		p.Pid, p.Title, p.Content, p.Author, p.Uid, p.Score, pq.Array(p.Tags), p.Status, p.BoardId,
 	}
 }
 func (p *UpdatePostParam) ToArgs() []interface{} {
 	return []interface{}{
-		p.Pid, p.Title, p.Content, p.Status, p.Score, p.Tags,
+		p.Pid, p.Title, p.Content, p.Status, p.Tags,
 	}
 }
+
+type SearchPostsParam struct {
+	Query    string     `json:"query"`
+	Tag      string     `json:"tag"`   // 为空时不过滤
+	Uid      int64      `json:"uid"`   // 为0时不过滤
+	Since    *time.Time `json:"since"` // 发帖时间下限，包含
+	Until    *time.Time `json:"until"` // 发帖时间上限，不包含
+	Page     int64      `json:"page"`
+	PageSize int64      `json:"page_size"`
+}
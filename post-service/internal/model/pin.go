@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// 置顶范围，全局置顶的帖子状态为4
+const (
+	PinScopeGlobal = "global"
+	PinScopeTag    = "tag"
+	PinScopeBoard  = "board"
+)
+
+// Pin 帖子在某个范围内置顶，ScopeKey为标签名或版块id，全局置顶时为空
+type Pin struct {
+	Pid        int64      `json:"pid" db:"pid"`
+	Scope      string     `json:"scope" db:"scope"`
+	ScopeKey   string     `json:"scope_key" db:"scope_key"`
+	PinnerUid  int64      `json:"pinner_uid" db:"pinner_uid"`
+	ExpireTime *time.Time `json:"expire_time" db:"expire_time"` // 为nil时不过期
+	CreateTime time.Time  `json:"create_time" db:"create_time"`
+}
@@ -1,6 +1,24 @@
 package model
 
-import "time"
+import (
+	"post-service/third_party/excerpt"
+	"time"
+)
+
+// PreviewExcerptLen 帖子简要信息中正文摘要的最大字符数
+const PreviewExcerptLen = 120
+
+// 帖子状态，待审核与隐藏的帖子只有作者与版主可见
+const (
+	StatusPending int32 = 0
+	StatusNormal  int32 = 1
+	StatusHidden  int32 = 2
+	StatusPinned  int32 = 4
+)
+
+func IsVisibleStatus(status int32) bool {
+	return status == StatusNormal || status == StatusPinned
+}
 
 type Post struct {
 	Id         int64     `json:"id" db:"id"`
@@ -17,6 +35,11 @@ type Post struct {
 	Tags       []string  `json:"tags" db:"tags"`
 	View       int64     `json:"view" db:"view"`
 	Like       int64     `json:"like" db:"like"`
+
+	CommentCount  int64 `json:"comment_count" db:"comment_count"`
+	FavoriteCount int64 `json:"favorite_count" db:"favorite_count"`
+	Version       int64 `json:"version" db:"version"` // 每次编辑后加1，用于乐观锁
+	BoardId       int64 `json:"board_id" db:"board_id"`
 }
 
 type PostPreview struct {
@@ -32,13 +55,19 @@ type PostPreview struct {
 	Tags       []string  `json:"tags" db:"tags"`
 	ViewCount  int64     `json:"view_count" db:"view"`
 	LikeCount  int64     `json:"like_count" db:"like"`
+
+	CommentCount  int64 `json:"comment_count" db:"comment_count"`
+	FavoriteCount int64 `json:"favorite_count" db:"favorite_count"`
+	BoardId       int64 `json:"board_id" db:"board_id"`
+
+	Pinned bool `json:"pinned" db:"-"` // 在当前列表中置顶
 }
 
 func (p *Post) ScanArgs() []any {
 	return []any{
 		&p.Id, &p.Pid, &p.IsDel, &p.CreateTime, &p.UpdateTime,
 		&p.Title, &p.Content, &p.Author, &p.Uid, &p.Status,
-		&p.Score, &p.Tags, &p.View, &p.Like,
+		&p.Score, &p.Tags, &p.View, &p.Like, &p.CommentCount, &p.FavoriteCount, &p.Version, &p.BoardId,
 	}
 }
 
@@ -49,13 +78,17 @@ func (p *Post) ToPreview() *PostPreview {
 		CreateTime: p.CreateTime,
 		UpdateTime: p.UpdateTime,
 		Title:      p.Title,
-		Content:    p.Content,
+		Content:    excerpt.Make(p.Content, PreviewExcerptLen),
 		Author:     p.Author,
 		Status:     p.Status,
 		Score:      p.Score,
 		Tags:       p.Tags,
 		ViewCount:  p.View,
 		LikeCount:  p.Like,
+
+		CommentCount:  p.CommentCount,
+		FavoriteCount: p.FavoriteCount,
+		BoardId:       p.BoardId,
 	}
 }
 
@@ -63,6 +96,61 @@ func (p *PostPreview) ScanArgs() []any {
 	return []any{
 		&p.Id, &p.Pid, &p.CreateTime, &p.UpdateTime,
 		&p.Title, &p.Content, &p.Author, &p.Status,
-		&p.Score, &p.Tags, &p.ViewCount, &p.LikeCount,
+		&p.Score, &p.Tags, &p.ViewCount, &p.LikeCount, &p.CommentCount, &p.FavoriteCount, &p.BoardId,
 	}
 }
+
+// PostCursor 游标分页的位置，编码后作为不透明的字符串返回给调用方
+// 按时间排序时使用UpdateTime，按热度排序时使用Score，Pid用于区分相同排序值的帖子
+type PostCursor struct {
+	Type       string `json:"t"`
+	UpdateTime int64  `json:"u,omitempty"` // 微秒时间戳
+	Score      int64  `json:"s,omitempty"` // 热度，与pg中的score一致
+	Pid        int64  `json:"p"`
+}
+
+type Tag struct {
+	Name      string  `json:"name" db:"name"`
+	PostCount int64   `json:"post_count" db:"post_count"`
+	Score     float64 `json:"score"` // 热门标签的热度
+}
+
+// SearchHit 搜索结果，高亮片段使用<em></em>标记命中的词
+type SearchHit struct {
+	Post             *PostPreview `json:"post"`
+	TitleHighlight   string       `json:"title_highlight"`
+	ContentHighlight string       `json:"content_highlight"`
+	Rank             float64      `json:"rank"`
+}
+
+// RankWeights 计算热度时各类互动的权重
+type RankWeights struct {
+	Like    float64
+	Comment float64
+	View    float64
+}
+
+// PostStat 重新计算热度所需的帖子数据
+type PostStat struct {
+	Pid          int64     `db:"pid"`
+	CreateTime   time.Time `db:"create_time"`
+	View         int64     `db:"view"`
+	Like         int64     `db:"like"`
+	CommentCount int64     `db:"comment_count"`
+}
+
+// LikeEvent 点赞与取消点赞事件，EventId用于消费时去重
+type LikeEvent struct {
+	EventId    int64     `json:"event_id"`
+	Pid        int64     `json:"pid"`
+	Uid        int64     `json:"uid"`
+	Delta      int64     `json:"delta"` // 1为点赞，-1为取消点赞
+	CreateTime time.Time `json:"create_time"`
+}
+
+// LikeCount 对账时post_info中的点赞数与post_like中的实际点赞人数
+type LikeCount struct {
+	Pid    int64
+	PgLike int64
+	Actual int64
+}
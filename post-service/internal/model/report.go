@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// 举报单状态
+const (
+	ReportCasePending   int32 = 0
+	ReportCaseResolved  int32 = 1
+	ReportCaseDismissed int32 = 2
+)
+
+type Report struct {
+	Id          int64     `json:"id" db:"id"`
+	CaseId      int64     `json:"case_id" db:"case_id"`
+	TargetType  string    `json:"target_type" db:"target_type"`
+	TargetId    int64     `json:"target_id" db:"target_id"`
+	ReporterUid int64     `json:"reporter_uid" db:"reporter_uid"`
+	Reason      string    `json:"reason" db:"reason"`
+	Detail      string    `json:"detail" db:"detail"`
+	Weight      int32     `json:"weight" db:"weight"`
+	CreateTime  time.Time `json:"create_time" db:"create_time"`
+}
+
+// ReportCase 同一内容的举报汇总为一个举报单，由版主统一处理
+type ReportCase struct {
+	Id          int64      `json:"id" db:"id"`
+	TargetType  string     `json:"target_type" db:"target_type"`
+	TargetId    int64      `json:"target_id" db:"target_id"`
+	OwnerUid    int64      `json:"owner_uid" db:"owner_uid"`
+	Excerpt     string     `json:"excerpt" db:"excerpt"`
+	Weight      int32      `json:"weight" db:"weight"`
+	ReportCount int32      `json:"report_count" db:"report_count"`
+	Hidden      bool       `json:"hidden" db:"hidden"`
+	Status      int32      `json:"status" db:"status"`
+	HandlerUid  int64      `json:"handler_uid" db:"handler_uid"`
+	Outcome     string     `json:"outcome" db:"outcome"`
+	CreateTime  time.Time  `json:"create_time" db:"create_time"`
+	HandleTime  *time.Time `json:"handle_time" db:"handle_time"`
+}
+
+// ReportResult Duplicated为true表示该用户已经举报过，本次举报不计入
+type ReportResult struct {
+	CaseId     int64
+	Duplicated bool
+	Hidden     bool // 本次举报后内容是否处于隐藏状态
+}
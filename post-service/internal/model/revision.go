@@ -0,0 +1,24 @@
+package model
+
+import (
+	"post-service/third_party/linediff"
+	"time"
+)
+
+// Revision 帖子某次编辑后的完整快照，Rev从1开始连续递增
+type Revision struct {
+	Pid        int64     `json:"pid" db:"pid"`
+	Rev        int32     `json:"rev" db:"rev"`
+	EditorUid  int64     `json:"editor_uid" db:"editor_uid"`
+	Title      string    `json:"title" db:"title"`
+	Content    string    `json:"content" db:"content"`
+	Tags       []string  `json:"tags" db:"tags"`
+	CreateTime time.Time `json:"create_time" db:"create_time"`
+}
+
+// RevisionDiff 两个版本之间正文的逐行差异，标题与标签直接比较From与To
+type RevisionDiff struct {
+	From  *Revision       `json:"from"`
+	To    *Revision       `json:"to"`
+	Lines []linediff.Line `json:"lines"`
+}
@@ -1,12 +1,16 @@
 package server
 
 import (
+	"context"
+	commentv1 "post-service/api/comment/v1"
+	favoritev1 "post-service/api/favorite/v1"
 	v1 "post-service/api/post/v1"
 	"post-service/internal/biz"
 	"post-service/internal/conf"
 	"post-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	kratosjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
@@ -15,7 +19,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, poster *service.PostSrvService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, poster *service.PostSrvService, commenter *service.CommentSrvService, favoriter *service.FavoriteSrvService, uc *biz.PostUsecase, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -27,6 +31,7 @@ func NewGRPCServer(c *conf.Server, poster *service.PostSrvService, logger log.Lo
 				return &biz.Claims{}
 			}), //在这里增加jwt的option
 			),
+			sessionMiddleware(uc),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -40,5 +45,19 @@ func NewGRPCServer(c *conf.Server, poster *service.PostSrvService, logger log.Lo
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterPostSrvServer(srv, poster)
+	commentv1.RegisterCommentSrvServer(srv, commenter)
+	favoritev1.RegisterFavoriteSrvServer(srv, favoriter)
 	return srv
 }
+
+// sessionMiddleware 拒绝已被吊销的会话，需要放在jwt中间件之后
+func sessionMiddleware(uc *biz.PostUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := uc.CheckSession(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
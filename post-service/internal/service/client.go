@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/peer"
+)
+
+// trustedProxies 网关等反向代理的地址，只有来自这些地址的请求才信任转发头
+type trustedProxies []netip.Prefix
+
+// newTrustedProxies 解析配置中的ip与cidr，配置错误时panic
+func newTrustedProxies(addrs []string) trustedProxies {
+	proxies := make(trustedProxies, 0, len(addrs))
+	for _, addr := range addrs {
+		if prefix, err := netip.ParsePrefix(addr); err == nil {
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		ip, err := netip.ParseAddr(addr)
+		if err != nil {
+			panic(fmt.Sprintf("invalid trusted proxy %q", addr))
+		}
+		proxies = append(proxies, netip.PrefixFrom(ip.Unmap(), ip.Unmap().BitLen()))
+	}
+	return proxies
+}
+
+func (t trustedProxies) contains(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIPFromCtx 获取调用方ip，对端是可信代理时以代理传递的地址为准
+// X-Forwarded-For从右往左取第一个不是可信代理的地址，左侧的地址可以被客户端伪造
+func (t trustedProxies) clientIPFromCtx(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	remote := addrPort.Addr().Unmap()
+	if !t.contains(remote) {
+		return remote.String()
+	}
+
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		header := tr.RequestHeader()
+		if ip, err := netip.ParseAddr(strings.TrimSpace(header.Get("X-Real-IP"))); err == nil {
+			return ip.Unmap().String()
+		}
+		if xff := header.Get("X-Forwarded-For"); xff != "" {
+			hops := strings.Split(xff, ",")
+			for i := len(hops) - 1; i >= 0; i-- {
+				ip, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+				if err != nil {
+					break
+				}
+				if !t.contains(ip) || i == 0 {
+					return ip.Unmap().String()
+				}
+			}
+		}
+	}
+	return remote.String()
+}
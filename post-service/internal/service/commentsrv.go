@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"post-service/internal/model"
+
+	pb "post-service/api/comment/v1"
+	"post-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CommentSrvService struct {
+	pb.UnimplementedCommentSrvServer
+
+	uc  *biz.CommentUsecase
+	log *log.Helper
+}
+
+func NewCommentSrvService(uc *biz.CommentUsecase, logger log.Logger) *CommentSrvService {
+	return &CommentSrvService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *CommentSrvService) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentReply, error) {
+	comment, err := s.uc.CreateComment(ctx, &model.CreateCommentParam{
+		Pid:     req.Pid,
+		Content: req.Content,
+		ReplyTo: req.ReplyTo,
+	})
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "CreateComment",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.CreateCommentReply{
+		Code:    200,
+		Comment: toPbComment(comment),
+	}, nil
+}
+
+func (s *CommentSrvService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+	if err := s.uc.DeleteComment(ctx, req.Cid); err != nil {
+		s.log.Errorw(
+			"[service]", "DeleteComment",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.DeleteCommentReply{
+		Code: 200,
+	}, nil
+}
+
+func (s *CommentSrvService) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsReply, error) {
+	comments, err := s.uc.ListComments(ctx, req.Pid, req.Page, req.PageSize, req.Type)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListComments",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ListCommentsReply{
+		Code:     200,
+		Comments: toPbComments(comments),
+	}, nil
+}
+
+func (s *CommentSrvService) ListReplies(ctx context.Context, req *pb.ListRepliesRequest) (*pb.ListRepliesReply, error) {
+	replies, err := s.uc.ListReplies(ctx, req.RootId, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListReplies",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ListRepliesReply{
+		Code:    200,
+		Replies: toPbComments(replies),
+	}, nil
+}
+
+func (s *CommentSrvService) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentReply, error) {
+	comment, err := s.uc.LikeComment(ctx, req.Cid, req.Like)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "LikeComment",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.LikeCommentReply{
+		Code:    200,
+		Comment: toPbComment(comment),
+	}, nil
+}
+
+func toPbComment(comment *model.Comment) *pb.Comment {
+	return &pb.Comment{
+		Id:         comment.Id,
+		Cid:        comment.Cid,
+		Pid:        comment.Pid,
+		CreateTime: timestamppb.New(comment.CreateTime),
+		Uid:        comment.Uid,
+		Author:     comment.Author,
+		Content:    comment.Content,
+		RootId:     comment.RootId,
+		ReplyToUid: comment.ReplyToUid,
+		LikeCount:  comment.Like,
+		ReplyCount: comment.ReplyCount,
+	}
+}
+
+func toPbComments(comments []*model.Comment) []*pb.Comment {
+	respComments := make([]*pb.Comment, 0, len(comments))
+	for _, comment := range comments {
+		respComments = append(respComments, toPbComment(comment))
+	}
+	return respComments
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"post-service/internal/model"
+
+	pb "post-service/api/favorite/v1"
+	"post-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FavoriteSrvService struct {
+	pb.UnimplementedFavoriteSrvServer
+
+	uc  *biz.FavoriteUsecase
+	log *log.Helper
+}
+
+func NewFavoriteSrvService(uc *biz.FavoriteUsecase, logger log.Logger) *FavoriteSrvService {
+	return &FavoriteSrvService{uc: uc, log: log.NewHelper(logger)}
+}
+
+func (s *FavoriteSrvService) AddFavorite(ctx context.Context, req *pb.AddFavoriteRequest) (*pb.AddFavoriteReply, error) {
+	if err := s.uc.AddFavorite(ctx, req.Pid, req.CollectionId); err != nil {
+		s.log.Errorw(
+			"[service]", "AddFavorite",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.AddFavoriteReply{Code: 200}, nil
+}
+
+func (s *FavoriteSrvService) RemoveFavorite(ctx context.Context, req *pb.RemoveFavoriteRequest) (*pb.RemoveFavoriteReply, error) {
+	if err := s.uc.RemoveFavorite(ctx, req.Pid); err != nil {
+		s.log.Errorw(
+			"[service]", "RemoveFavorite",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.RemoveFavoriteReply{Code: 200}, nil
+}
+
+func (s *FavoriteSrvService) ListFavorites(ctx context.Context, req *pb.ListFavoritesRequest) (*pb.ListFavoritesReply, error) {
+	favs, err := s.uc.ListFavorites(ctx, req.CollectionId, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListFavorites",
+			"err", err,
+		)
+		return nil, err
+	}
+	respFavs := make([]*pb.Favorite, 0, len(favs))
+	for _, fav := range favs {
+		respFav := &pb.Favorite{
+			Pid:          fav.Pid,
+			CollectionId: fav.CollectionId,
+			CreateTime:   timestamppb.New(fav.CreateTime),
+			Removed:      fav.Removed,
+		}
+		if fav.Post != nil {
+			respFav.Post = toPbPostPreview(fav.Post)
+		}
+		respFavs = append(respFavs, respFav)
+	}
+	return &pb.ListFavoritesReply{
+		Code:      200,
+		Favorites: respFavs,
+	}, nil
+}
+
+func (s *FavoriteSrvService) MoveFavorite(ctx context.Context, req *pb.MoveFavoriteRequest) (*pb.MoveFavoriteReply, error) {
+	if err := s.uc.MoveFavorite(ctx, req.Pid, req.CollectionId); err != nil {
+		s.log.Errorw(
+			"[service]", "MoveFavorite",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.MoveFavoriteReply{Code: 200}, nil
+}
+
+func (s *FavoriteSrvService) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.CreateCollectionReply, error) {
+	collection, err := s.uc.CreateCollection(ctx, req.Name)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "CreateCollection",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.CreateCollectionReply{
+		Code:       200,
+		Collection: toPbCollection(collection),
+	}, nil
+}
+
+func (s *FavoriteSrvService) RenameCollection(ctx context.Context, req *pb.RenameCollectionRequest) (*pb.RenameCollectionReply, error) {
+	if err := s.uc.RenameCollection(ctx, req.CollectionId, req.Name); err != nil {
+		s.log.Errorw(
+			"[service]", "RenameCollection",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.RenameCollectionReply{Code: 200}, nil
+}
+
+func (s *FavoriteSrvService) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionReply, error) {
+	if err := s.uc.DeleteCollection(ctx, req.CollectionId); err != nil {
+		s.log.Errorw(
+			"[service]", "DeleteCollection",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.DeleteCollectionReply{Code: 200}, nil
+}
+
+func (s *FavoriteSrvService) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsReply, error) {
+	collections, err := s.uc.ListCollections(ctx)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListCollections",
+			"err", err,
+		)
+		return nil, err
+	}
+	respCollections := make([]*pb.Collection, 0, len(collections))
+	for _, collection := range collections {
+		respCollections = append(respCollections, toPbCollection(collection))
+	}
+	return &pb.ListCollectionsReply{
+		Code:        200,
+		Collections: respCollections,
+	}, nil
+}
+
+func toPbCollection(collection *model.Collection) *pb.Collection {
+	return &pb.Collection{
+		CollectionId:  collection.CollectionId,
+		Name:          collection.Name,
+		CreateTime:    timestamppb.New(collection.CreateTime),
+		FavoriteCount: collection.FavoriteCount,
+	}
+}
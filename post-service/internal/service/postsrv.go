@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"post-service/internal/model"
+	"time"
 
 	pb "post-service/api/post/v1"
 	"post-service/internal/biz"
+	"post-service/internal/conf"
+	"post-service/third_party/linediff"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,12 +18,13 @@ import (
 type PostSrvService struct {
 	pb.UnimplementedPostSrvServer
 
-	uc  *biz.PostUsecase
-	log *log.Helper
+	uc      *biz.PostUsecase
+	proxies trustedProxies
+	log     *log.Helper
 }
 
-func NewPostSrvService(uc *biz.PostUsecase, logger log.Logger) *PostSrvService {
-	return &PostSrvService{uc: uc, log: log.NewHelper(logger)}
+func NewPostSrvService(c *conf.Server, uc *biz.PostUsecase, logger log.Logger) *PostSrvService {
+	return &PostSrvService{uc: uc, proxies: newTrustedProxies(c.TrustedProxies), log: log.NewHelper(logger)}
 }
 
 var (
@@ -30,12 +34,13 @@ var (
 func (s *PostSrvService) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb.CreatePostReply, error) {
 	// 请求处理
 	if req.Tags == nil {
-		req.Tags = []string{"default"}
+		req.Tags = []string{biz.DefaultTag}
 	}
 	param := model.CreatePostParam{
 		Title:   req.Title,
 		Content: req.Content,
 		Tags:    req.Tags,
+		BoardId: req.BoardId,
 	}
 
 	// 调用 Usecase 层的业务逻辑
@@ -48,26 +53,9 @@ func (s *PostSrvService) CreatePost(ctx context.Context, req *pb.CreatePostReque
 		return nil, err
 	}
 
-	respPost := pb.Post{
-		Id:         post.Id,
-		Pid:        post.Pid,
-		IsDel:      post.IsDel,
-		CreateTime: timestamppb.New(post.CreateTime),
-		UpdateTime: timestamppb.New(post.UpdateTime),
-
-		Title:     post.Title,
-		Content:   post.Content,
-		Author:    post.Author,
-		Uid:       post.Uid,
-		Status:    post.Status,
-		Score:     post.Score,
-		Tags:      post.Tags,
-		ViewCount: post.View,
-		LikeCount: post.Like,
-	}
 	return &pb.CreatePostReply{
 		Code: 200,
-		Post: &respPost,
+		Post: toPbPost(post),
 	}, nil
 }
 func (s *PostSrvService) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest) (*pb.UpdatePostReply, error) {
@@ -83,8 +71,8 @@ func (s *PostSrvService) UpdatePost(ctx context.Context, req *pb.UpdatePostReque
 		Title:   req.Title,
 		Content: req.Content,
 		Status:  req.Status,
-		Score:   req.Score,
 		Tags:    req.Tags,
+		Version: req.GetVersion(),
 	}
 
 	// 调用 Usecase 层的业务逻辑
@@ -96,26 +84,9 @@ func (s *PostSrvService) UpdatePost(ctx context.Context, req *pb.UpdatePostReque
 		)
 		return nil, err
 	}
-	respPost := pb.Post{
-		Id:         post.Id,
-		Pid:        post.Pid,
-		IsDel:      post.IsDel,
-		CreateTime: timestamppb.New(post.CreateTime),
-		UpdateTime: timestamppb.New(post.UpdateTime),
-		Title:      post.Title,
-		Content:    post.Content,
-		Author:     post.Author,
-		Uid:        post.Uid,
-		Status:     post.Status,
-		Score:      post.Score,
-		Tags:       post.Tags,
-		ViewCount:  post.View,
-		LikeCount:  post.Like,
-	}
-
 	return &pb.UpdatePostReply{
 		Code: 200,
-		Post: &respPost,
+		Post: toPbPost(post),
 	}, nil
 }
 func (s *PostSrvService) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostReply, error) {
@@ -133,12 +104,42 @@ func (s *PostSrvService) DeletePost(ctx context.Context, req *pb.DeletePostReque
 }
 
 func (s *PostSrvService) GetPostPreview(ctx context.Context, req *pb.GetPostPreviewRequest) (*pb.GetPostPreviewReply, error) {
-	return nil, errors.New("该接口未实现")
+	post, err := s.uc.GetPostPreview(ctx, req.Pid)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "GetPostPreview",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.GetPostPreviewReply{
+		Code: 200,
+		Post: toPbPostPreview(post),
+	}, nil
+}
+
+func (s *PostSrvService) BatchGetPostPreviews(ctx context.Context, req *pb.BatchGetPostPreviewsRequest) (*pb.BatchGetPostPreviewsReply, error) {
+	posts, err := s.uc.BatchGetPostPreviews(ctx, req.Pids)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "BatchGetPostPreviews",
+			"err", err,
+		)
+		return nil, err
+	}
+	respPosts := make([]*pb.PostPreview, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, toPbPostPreview(post))
+	}
+	return &pb.BatchGetPostPreviewsReply{
+		Code:  200,
+		Posts: respPosts,
+	}, nil
 }
 
 func (s *PostSrvService) GetPostDetail(ctx context.Context, req *pb.GetPostDetailRequest) (*pb.GetPostDetailReply, error) {
 	pid := req.Pid
-	post, err := s.uc.GetPostById(ctx, pid)
+	post, err := s.uc.GetPostDetail(ctx, pid, s.proxies.clientIPFromCtx(ctx))
 	if err != nil {
 		s.log.Errorw(
 			"[service]", "GetPostDetail",
@@ -148,30 +149,25 @@ func (s *PostSrvService) GetPostDetail(ctx context.Context, req *pb.GetPostDetai
 	}
 	return &pb.GetPostDetailReply{
 		Code: 200,
-		Post: &pb.Post{
-			Id:         post.Id,
-			Pid:        post.Pid,
-			IsDel:      post.IsDel,
-			CreateTime: timestamppb.New(post.CreateTime),
-			UpdateTime: timestamppb.New(post.UpdateTime),
-			Title:      post.Title,
-			Content:    post.Content,
-			Author:     post.Author,
-			Uid:        post.Uid,
-			Status:     post.Status,
-			Score:      post.Score,
-			Tags:       post.Tags,
-			ViewCount:  post.View,
-			LikeCount:  post.Like,
-		},
+		Post: toPbPost(post),
 	}, nil
 }
 func (s *PostSrvService) ListPostPreview(ctx context.Context, req *pb.ListPostPreviewRequest) (*pb.ListPostPreviewReply, error) {
 	page := req.Page
 	size := req.PageSize
 	searchType := req.Type
+	boardId := req.GetBoardId()
 
-	posts, err := s.uc.ListPostPreview(ctx, page, size, searchType)
+	var (
+		posts      []*model.PostPreview
+		nextCursor string
+		err        error
+	)
+	if req.Cursor != nil {
+		posts, nextCursor, err = s.uc.ListPostPreviewByCursor(ctx, boardId, *req.Cursor, size, searchType)
+	} else {
+		posts, err = s.uc.ListPostPreview(ctx, boardId, page, size, searchType)
+	}
 	if err != nil {
 		s.log.Errorw(
 			"[service]", "ListPost",
@@ -182,25 +178,13 @@ func (s *PostSrvService) ListPostPreview(ctx context.Context, req *pb.ListPostPr
 
 	respPosts := make([]*pb.PostPreview, 0, len(posts))
 	for _, post := range posts {
-		respPosts = append(respPosts, &pb.PostPreview{
-			Id:         post.Id,
-			Pid:        post.Pid,
-			CreateTime: timestamppb.New(post.CreateTime),
-			UpdateTime: timestamppb.New(post.UpdateTime),
-			Title:      post.Title,
-			Content:    post.Content,
-			Author:     post.Author,
-			Status:     post.Status,
-			Score:      post.Score,
-			Tags:       post.Tags,
-			ViewCount:  post.ViewCount,
-			LikeCount:  post.LikeCount,
-		})
+		respPosts = append(respPosts, toPbPostPreview(post))
 	}
 
 	return &pb.ListPostPreviewReply{
-		Code:  200,
-		Posts: respPosts,
+		Code:       200,
+		Posts:      respPosts,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -213,24 +197,755 @@ func (s *PostSrvService) AddPostLike(ctx context.Context, req *pb.AddPostLikeReq
 		)
 		return nil, err
 	}
-	respPost := pb.Post{
-		Id:         post.Id,
-		Pid:        post.Pid,
-		IsDel:      post.IsDel,
-		CreateTime: timestamppb.New(post.CreateTime),
-		UpdateTime: timestamppb.New(post.UpdateTime),
-		Title:      post.Title,
-		Content:    post.Content,
-		Author:     post.Author,
-		Uid:        post.Uid,
-		Status:     post.Status,
-		Score:      post.Score,
-		Tags:       post.Tags,
-		ViewCount:  post.View,
-		LikeCount:  post.Like,
-	}
 	return &pb.AddPostLikeReply{
 		Code: 200,
-		Post: &respPost,
+		Post: toPbPost(post),
+	}, nil
+}
+
+func toPbPostPreview(post *model.PostPreview) *pb.PostPreview {
+	return &pb.PostPreview{
+		Id:            post.Id,
+		Pid:           post.Pid,
+		CreateTime:    timestamppb.New(post.CreateTime),
+		UpdateTime:    timestamppb.New(post.UpdateTime),
+		Title:         post.Title,
+		Content:       post.Content,
+		Author:        post.Author,
+		Status:        post.Status,
+		Score:         post.Score,
+		Tags:          post.Tags,
+		ViewCount:     post.ViewCount,
+		LikeCount:     post.LikeCount,
+		CommentCount:  post.CommentCount,
+		FavoriteCount: post.FavoriteCount,
+		BoardId:       post.BoardId,
+		Pinned:        post.Pinned,
+	}
+}
+
+func toPbPost(post *model.Post) *pb.Post {
+	return &pb.Post{
+		Id:            post.Id,
+		Pid:           post.Pid,
+		IsDel:         post.IsDel,
+		CreateTime:    timestamppb.New(post.CreateTime),
+		UpdateTime:    timestamppb.New(post.UpdateTime),
+		Title:         post.Title,
+		Content:       post.Content,
+		Author:        post.Author,
+		Uid:           post.Uid,
+		Status:        post.Status,
+		Score:         post.Score,
+		Tags:          post.Tags,
+		ViewCount:     post.View,
+		LikeCount:     post.Like,
+		CommentCount:  post.CommentCount,
+		FavoriteCount: post.FavoriteCount,
+		Version:       post.Version,
+		BoardId:       post.BoardId,
+	}
+}
+
+func (s *PostSrvService) ListMyLikedPosts(ctx context.Context, req *pb.ListMyLikedPostsRequest) (*pb.ListMyLikedPostsReply, error) {
+	posts, err := s.uc.ListMyLikedPosts(ctx, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListMyLikedPosts",
+			"err", err,
+		)
+		return nil, err
+	}
+	respPosts := make([]*pb.PostPreview, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, toPbPostPreview(post))
+	}
+	return &pb.ListMyLikedPostsReply{
+		Code:  200,
+		Posts: respPosts,
 	}, nil
 }
+
+func (s *PostSrvService) ListLeaderboard(ctx context.Context, req *pb.ListLeaderboardRequest) (*pb.ListLeaderboardReply, error) {
+	posts, err := s.uc.ListLeaderboard(ctx, req.Period, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListLeaderboard",
+			"err", err,
+		)
+		return nil, err
+	}
+	respPosts := make([]*pb.PostPreview, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, toPbPostPreview(post))
+	}
+	return &pb.ListLeaderboardReply{
+		Code:  200,
+		Posts: respPosts,
+	}, nil
+}
+
+func (s *PostSrvService) ListPostsByTag(ctx context.Context, req *pb.ListPostsByTagRequest) (*pb.ListPostsByTagReply, error) {
+	posts, err := s.uc.ListPostsByTag(ctx, req.Tag, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListPostsByTag",
+			"err", err,
+		)
+		return nil, err
+	}
+	respPosts := make([]*pb.PostPreview, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, toPbPostPreview(post))
+	}
+	return &pb.ListPostsByTagReply{
+		Code:  200,
+		Posts: respPosts,
+	}, nil
+}
+
+func (s *PostSrvService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsReply, error) {
+	tags, err := s.uc.ListTags(ctx, req.Prefix, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListTags",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ListTagsReply{
+		Code: 200,
+		Tags: toPbTags(tags),
+	}, nil
+}
+
+func (s *PostSrvService) ListTrendingTags(ctx context.Context, req *pb.ListTrendingTagsRequest) (*pb.ListTrendingTagsReply, error) {
+	tags, err := s.uc.ListTrendingTags(ctx, req.Days, req.Limit)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListTrendingTags",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ListTrendingTagsReply{
+		Code: 200,
+		Tags: toPbTags(tags),
+	}, nil
+}
+
+func toPbTags(tags []*model.Tag) []*pb.Tag {
+	respTags := make([]*pb.Tag, 0, len(tags))
+	for _, tag := range tags {
+		respTags = append(respTags, &pb.Tag{
+			Name:      tag.Name,
+			PostCount: tag.PostCount,
+			Score:     tag.Score,
+		})
+	}
+	return respTags
+}
+
+func (s *PostSrvService) SearchPosts(ctx context.Context, req *pb.SearchPostsRequest) (*pb.SearchPostsReply, error) {
+	param := &model.SearchPostsParam{
+		Query:    req.Query,
+		Tag:      req.GetTag(),
+		Uid:      req.GetUid(),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	if req.Since != nil {
+		since := req.Since.AsTime()
+		param.Since = &since
+	}
+	if req.Until != nil {
+		until := req.Until.AsTime()
+		param.Until = &until
+	}
+
+	hits, err := s.uc.SearchPosts(ctx, param)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "SearchPosts",
+			"err", err,
+		)
+		return nil, err
+	}
+	respHits := make([]*pb.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		respHits = append(respHits, &pb.SearchHit{
+			Post:             toPbPostPreview(hit.Post),
+			TitleHighlight:   hit.TitleHighlight,
+			ContentHighlight: hit.ContentHighlight,
+			Rank:             hit.Rank,
+		})
+	}
+	return &pb.SearchPostsReply{
+		Code: 200,
+		Hits: respHits,
+	}, nil
+}
+
+func (s *PostSrvService) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.SaveDraftReply, error) {
+	param := &model.SaveDraftParam{
+		Did:     req.Did,
+		Title:   req.Title,
+		Content: req.Content,
+		Tags:    req.Tags,
+		BoardId: req.BoardId,
+	}
+	if req.PublishAt != nil {
+		publishAt := req.PublishAt.AsTime()
+		param.PublishAt = &publishAt
+	}
+	draft, err := s.uc.SaveDraft(ctx, param)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "SaveDraft",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.SaveDraftReply{
+		Code:  200,
+		Draft: toPbDraft(draft),
+	}, nil
+}
+
+func (s *PostSrvService) ListDrafts(ctx context.Context, req *pb.ListDraftsRequest) (*pb.ListDraftsReply, error) {
+	drafts, err := s.uc.ListDrafts(ctx, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListDrafts",
+			"err", err,
+		)
+		return nil, err
+	}
+	respDrafts := make([]*pb.Draft, 0, len(drafts))
+	for _, draft := range drafts {
+		respDrafts = append(respDrafts, toPbDraft(draft))
+	}
+	return &pb.ListDraftsReply{
+		Code:   200,
+		Drafts: respDrafts,
+	}, nil
+}
+
+func (s *PostSrvService) DeleteDraft(ctx context.Context, req *pb.DeleteDraftRequest) (*pb.DeleteDraftReply, error) {
+	if err := s.uc.DeleteDraft(ctx, req.Did); err != nil {
+		s.log.Errorw(
+			"[service]", "DeleteDraft",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.DeleteDraftReply{
+		Code: 200,
+	}, nil
+}
+
+func (s *PostSrvService) PublishDraft(ctx context.Context, req *pb.PublishDraftRequest) (*pb.PublishDraftReply, error) {
+	post, err := s.uc.PublishDraft(ctx, req.Did)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "PublishDraft",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.PublishDraftReply{
+		Code: 200,
+		Post: toPbPost(post),
+	}, nil
+}
+
+func toPbDraft(draft *model.Draft) *pb.Draft {
+	respDraft := &pb.Draft{
+		Did:        draft.Did,
+		Title:      draft.Title,
+		Content:    draft.Content,
+		Tags:       draft.Tags,
+		BoardId:    draft.BoardId,
+		CreateTime: timestamppb.New(draft.CreateTime),
+		UpdateTime: timestamppb.New(draft.UpdateTime),
+	}
+	if draft.PublishAt != nil {
+		respDraft.PublishAt = timestamppb.New(*draft.PublishAt)
+	}
+	return respDraft
+}
+
+func (s *PostSrvService) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.ListRevisionsReply, error) {
+	revisions, err := s.uc.ListRevisions(ctx, req.Pid, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListRevisions",
+			"err", err,
+		)
+		return nil, err
+	}
+	respRevisions := make([]*pb.Revision, 0, len(revisions))
+	for _, revision := range revisions {
+		respRevisions = append(respRevisions, toPbRevision(revision))
+	}
+	return &pb.ListRevisionsReply{
+		Code:      200,
+		Revisions: respRevisions,
+	}, nil
+}
+
+func (s *PostSrvService) GetRevision(ctx context.Context, req *pb.GetRevisionRequest) (*pb.GetRevisionReply, error) {
+	revision, err := s.uc.GetRevision(ctx, req.Pid, req.Rev)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "GetRevision",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.GetRevisionReply{
+		Code:     200,
+		Revision: toPbRevision(revision),
+	}, nil
+}
+
+func (s *PostSrvService) DiffRevisions(ctx context.Context, req *pb.DiffRevisionsRequest) (*pb.DiffRevisionsReply, error) {
+	diff, err := s.uc.DiffRevisions(ctx, req.Pid, req.FromRev, req.ToRev)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "DiffRevisions",
+			"err", err,
+		)
+		return nil, err
+	}
+	from, to := toPbRevision(diff.From), toPbRevision(diff.To)
+	from.Content, to.Content = "", ""
+	lines := make([]*pb.DiffLine, 0, len(diff.Lines))
+	for _, line := range diff.Lines {
+		lines = append(lines, &pb.DiffLine{
+			Op:   diffOps[line.Op],
+			Text: line.Text,
+		})
+	}
+	return &pb.DiffRevisionsReply{
+		Code:  200,
+		From:  from,
+		To:    to,
+		Lines: lines,
+	}, nil
+}
+
+func (s *PostSrvService) RestoreRevision(ctx context.Context, req *pb.RestoreRevisionRequest) (*pb.RestoreRevisionReply, error) {
+	post, err := s.uc.RestoreRevision(ctx, req.Pid, req.Rev)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "RestoreRevision",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.RestoreRevisionReply{
+		Code: 200,
+		Post: toPbPost(post),
+	}, nil
+}
+
+var diffOps = map[linediff.Op]string{
+	linediff.Equal:  " ",
+	linediff.Delete: "-",
+	linediff.Insert: "+",
+}
+
+func toPbRevision(revision *model.Revision) *pb.Revision {
+	return &pb.Revision{
+		Pid:        revision.Pid,
+		Rev:        revision.Rev,
+		EditorUid:  revision.EditorUid,
+		Title:      revision.Title,
+		Content:    revision.Content,
+		Tags:       revision.Tags,
+		CreateTime: timestamppb.New(revision.CreateTime),
+	}
+}
+
+func (s *PostSrvService) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueReply, error) {
+	posts, err := s.uc.ListModerationQueue(ctx, req.GetBoardId(), req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListModerationQueue",
+			"err", err,
+		)
+		return nil, err
+	}
+	respPosts := make([]*pb.PostPreview, 0, len(posts))
+	for _, post := range posts {
+		respPosts = append(respPosts, toPbPostPreview(post))
+	}
+	return &pb.ListModerationQueueReply{
+		Code:  200,
+		Posts: respPosts,
+	}, nil
+}
+
+func (s *PostSrvService) ApprovePost(ctx context.Context, req *pb.ApprovePostRequest) (*pb.ApprovePostReply, error) {
+	post, err := s.uc.ApprovePost(ctx, req.Pid)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ApprovePost",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ApprovePostReply{
+		Code: 200,
+		Post: toPbPost(post),
+	}, nil
+}
+
+func (s *PostSrvService) RejectPost(ctx context.Context, req *pb.RejectPostRequest) (*pb.RejectPostReply, error) {
+	post, err := s.uc.RejectPost(ctx, req.Pid, req.Reason)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "RejectPost",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.RejectPostReply{
+		Code: 200,
+		Post: toPbPost(post),
+	}, nil
+}
+
+func (s *PostSrvService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsReply, error) {
+	notifications, err := s.uc.ListNotifications(ctx, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListNotifications",
+			"err", err,
+		)
+		return nil, err
+	}
+	respNotifications := make([]*pb.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		respNotifications = append(respNotifications, &pb.Notification{
+			Id:         n.Id,
+			Type:       n.Type,
+			Pid:        n.Pid,
+			Content:    n.Content,
+			IsRead:     n.IsRead,
+			CreateTime: timestamppb.New(n.CreateTime),
+		})
+	}
+	return &pb.ListNotificationsReply{
+		Code:          200,
+		Notifications: respNotifications,
+	}, nil
+}
+
+func (s *PostSrvService) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadReply, error) {
+	marked, err := s.uc.MarkNotificationsRead(ctx, req.MaxId)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "MarkNotificationsRead",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.MarkNotificationsReadReply{
+		Code:   200,
+		Marked: marked,
+	}, nil
+}
+
+func (s *PostSrvService) ListFilterLogs(ctx context.Context, req *pb.ListFilterLogsRequest) (*pb.ListFilterLogsReply, error) {
+	logs, err := s.uc.ListFilterLogs(ctx, req.GetTargetType(), req.GetTargetId(), req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListFilterLogs",
+			"err", err,
+		)
+		return nil, err
+	}
+	respLogs := make([]*pb.FilterLog, 0, len(logs))
+	for _, l := range logs {
+		respLogs = append(respLogs, &pb.FilterLog{
+			Id:         l.Id,
+			TargetType: l.TargetType,
+			TargetId:   l.TargetId,
+			Uid:        l.Uid,
+			Action:     l.Action,
+			Categories: l.Categories,
+			Terms:      l.Terms,
+			CreateTime: timestamppb.New(l.CreateTime),
+		})
+	}
+	return &pb.ListFilterLogsReply{
+		Code: 200,
+		Logs: respLogs,
+	}, nil
+}
+
+func (s *PostSrvService) ReportContent(ctx context.Context, req *pb.ReportContentRequest) (*pb.ReportContentReply, error) {
+	err := s.uc.ReportContent(ctx, &model.Report{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Reason:     req.Reason,
+		Detail:     req.Detail,
+	})
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ReportContent",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ReportContentReply{
+		Code: 200,
+	}, nil
+}
+
+func (s *PostSrvService) ListReportCases(ctx context.Context, req *pb.ListReportCasesRequest) (*pb.ListReportCasesReply, error) {
+	cases, err := s.uc.ListReportCases(ctx, req.Status, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListReportCases",
+			"err", err,
+		)
+		return nil, err
+	}
+	respCases := make([]*pb.ReportCase, 0, len(cases))
+	for _, c := range cases {
+		respCases = append(respCases, toPbReportCase(c))
+	}
+	return &pb.ListReportCasesReply{
+		Code:  200,
+		Cases: respCases,
+	}, nil
+}
+
+func (s *PostSrvService) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsReply, error) {
+	reports, err := s.uc.ListReports(ctx, req.CaseId, req.Page, req.PageSize)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListReports",
+			"err", err,
+		)
+		return nil, err
+	}
+	respReports := make([]*pb.Report, 0, len(reports))
+	for _, r := range reports {
+		respReports = append(respReports, &pb.Report{
+			Id:          r.Id,
+			CaseId:      r.CaseId,
+			ReporterUid: r.ReporterUid,
+			Reason:      r.Reason,
+			Detail:      r.Detail,
+			Weight:      r.Weight,
+			CreateTime:  timestamppb.New(r.CreateTime),
+		})
+	}
+	return &pb.ListReportsReply{
+		Code:    200,
+		Reports: respReports,
+	}, nil
+}
+
+func (s *PostSrvService) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ResolveReportReply, error) {
+	reportCase, err := s.uc.ResolveReport(ctx, req.CaseId, req.Outcome)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ResolveReport",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.ResolveReportReply{
+		Code: 200,
+		Case: toPbReportCase(reportCase),
+	}, nil
+}
+
+func (s *PostSrvService) DismissReport(ctx context.Context, req *pb.DismissReportRequest) (*pb.DismissReportReply, error) {
+	reportCase, err := s.uc.DismissReport(ctx, req.CaseId, req.Outcome)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "DismissReport",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.DismissReportReply{
+		Code: 200,
+		Case: toPbReportCase(reportCase),
+	}, nil
+}
+
+func toPbReportCase(c *model.ReportCase) *pb.ReportCase {
+	respCase := &pb.ReportCase{
+		Id:          c.Id,
+		TargetType:  c.TargetType,
+		TargetId:    c.TargetId,
+		OwnerUid:    c.OwnerUid,
+		Excerpt:     c.Excerpt,
+		Weight:      c.Weight,
+		ReportCount: c.ReportCount,
+		Hidden:      c.Hidden,
+		Status:      c.Status,
+		HandlerUid:  c.HandlerUid,
+		Outcome:     c.Outcome,
+		CreateTime:  timestamppb.New(c.CreateTime),
+	}
+	if c.HandleTime != nil {
+		respCase.HandleTime = timestamppb.New(*c.HandleTime)
+	}
+	return respCase
+}
+
+func (s *PostSrvService) PinPost(ctx context.Context, req *pb.PinPostRequest) (*pb.PinPostReply, error) {
+	var expireAt *time.Time
+	if req.ExpireTime != nil {
+		t := req.ExpireTime.AsTime()
+		expireAt = &t
+	}
+	if err := s.uc.PinPost(ctx, req.Pid, req.Scope, req.ScopeKey, expireAt); err != nil {
+		s.log.Errorw(
+			"[service]", "PinPost",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.PinPostReply{
+		Code: 200,
+	}, nil
+}
+
+func (s *PostSrvService) UnpinPost(ctx context.Context, req *pb.UnpinPostRequest) (*pb.UnpinPostReply, error) {
+	if err := s.uc.UnpinPost(ctx, req.Pid, req.Scope, req.ScopeKey); err != nil {
+		s.log.Errorw(
+			"[service]", "UnpinPost",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.UnpinPostReply{
+		Code: 200,
+	}, nil
+}
+
+func (s *PostSrvService) CreateBoard(ctx context.Context, req *pb.CreateBoardRequest) (*pb.CreateBoardReply, error) {
+	board, err := s.uc.CreateBoard(ctx, &model.Board{
+		Name:           req.Name,
+		Slug:           req.Slug,
+		Description:    req.Description,
+		PostPermission: req.PostPermission,
+	})
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "CreateBoard",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.CreateBoardReply{
+		Code:  200,
+		Board: toPbBoard(board),
+	}, nil
+}
+
+func (s *PostSrvService) UpdateBoard(ctx context.Context, req *pb.UpdateBoardRequest) (*pb.UpdateBoardReply, error) {
+	board, err := s.uc.UpdateBoard(ctx, &model.UpdateBoardParam{
+		Id:             req.Id,
+		Name:           req.Name,
+		Description:    req.Description,
+		PostPermission: req.PostPermission,
+	})
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "UpdateBoard",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.UpdateBoardReply{
+		Code:  200,
+		Board: toPbBoard(board),
+	}, nil
+}
+
+func (s *PostSrvService) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardReply, error) {
+	board, err := s.uc.GetBoard(ctx, req.Id, req.Slug)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "GetBoard",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.GetBoardReply{
+		Code:  200,
+		Board: toPbBoard(board),
+	}, nil
+}
+
+func (s *PostSrvService) ListBoards(ctx context.Context, req *pb.ListBoardsRequest) (*pb.ListBoardsReply, error) {
+	boards, err := s.uc.ListBoards(ctx)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "ListBoards",
+			"err", err,
+		)
+		return nil, err
+	}
+	respBoards := make([]*pb.Board, 0, len(boards))
+	for _, board := range boards {
+		respBoards = append(respBoards, toPbBoard(board))
+	}
+	return &pb.ListBoardsReply{
+		Code:   200,
+		Boards: respBoards,
+	}, nil
+}
+
+func (s *PostSrvService) AddBoardModerator(ctx context.Context, req *pb.AddBoardModeratorRequest) (*pb.AddBoardModeratorReply, error) {
+	board, err := s.uc.AddBoardModerator(ctx, req.BoardId, req.Uid)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "AddBoardModerator",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.AddBoardModeratorReply{
+		Code:  200,
+		Board: toPbBoard(board),
+	}, nil
+}
+
+func (s *PostSrvService) RemoveBoardModerator(ctx context.Context, req *pb.RemoveBoardModeratorRequest) (*pb.RemoveBoardModeratorReply, error) {
+	board, err := s.uc.RemoveBoardModerator(ctx, req.BoardId, req.Uid)
+	if err != nil {
+		s.log.Errorw(
+			"[service]", "RemoveBoardModerator",
+			"err", err,
+		)
+		return nil, err
+	}
+	return &pb.RemoveBoardModeratorReply{
+		Code:  200,
+		Board: toPbBoard(board),
+	}, nil
+}
+
+func toPbBoard(board *model.Board) *pb.Board {
+	return &pb.Board{
+		Id:             board.Id,
+		Name:           board.Name,
+		Slug:           board.Slug,
+		Description:    board.Description,
+		PostPermission: board.PostPermission,
+		Moderators:     board.Moderators,
+		CreateTime:     timestamppb.New(board.CreateTime),
+		UpdateTime:     timestamppb.New(board.UpdateTime),
+	}
+}
@@ -0,0 +1,123 @@
+package ahocorasick
+
+import "unicode"
+
+// Match 一次命中，Start与End为命中部分在文本中的rune下标，左闭右开，Index为命中的词在词表中的下标
+type Match struct {
+	Start int
+	End   int
+	Index int
+}
+
+type node struct {
+	next map[rune]int32
+	fail int32
+	// out 以该节点结尾的词，-1表示没有
+	out int32
+	// link 沿失败指针能到达的下一个有词结尾的节点，-1表示没有
+	link  int32
+	depth int32
+}
+
+// Matcher 多模式匹配，构建后只读，可以被多个协程同时使用
+// 匹配时不区分大小写
+type Matcher struct {
+	nodes []node
+}
+
+// New 构建匹配器，空词会被忽略，重复的词只保留第一个的下标
+func New(words []string) *Matcher {
+	m := &Matcher{nodes: []node{newNode(0)}}
+	for i, word := range words {
+		m.insert(word, int32(i))
+	}
+	m.build()
+	return m
+}
+
+func newNode(depth int32) node {
+	return node{next: make(map[rune]int32), out: -1, link: -1, depth: depth}
+}
+
+func (m *Matcher) insert(word string, index int32) {
+	if word == "" {
+		return
+	}
+	cur := int32(0)
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		nxt, ok := m.nodes[cur].next[r]
+		if !ok {
+			nxt = int32(len(m.nodes))
+			m.nodes = append(m.nodes, newNode(m.nodes[cur].depth+1))
+			m.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	if m.nodes[cur].out == -1 {
+		m.nodes[cur].out = index
+	}
+}
+
+// build 按层序计算失败指针与输出链接
+func (m *Matcher) build() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f != 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			fail := m.nodes[child].fail
+			if m.nodes[fail].out != -1 {
+				m.nodes[child].link = fail
+			} else {
+				m.nodes[child].link = m.nodes[fail].link
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// FindAll 返回文本中所有命中，包括相互重叠的命中，按结束位置排序
+func (m *Matcher) FindAll(text string) []Match {
+	var matches []Match
+	cur := int32(0)
+	pos := 0
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		for {
+			if nxt, ok := m.nodes[cur].next[r]; ok {
+				cur = nxt
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		pos++
+		for n := cur; n != -1; n = m.nodes[n].link {
+			if m.nodes[n].out == -1 {
+				continue
+			}
+			matches = append(matches, Match{
+				Start: pos - int(m.nodes[n].depth),
+				End:   pos,
+				Index: int(m.nodes[n].out),
+			})
+		}
+	}
+	return matches
+}
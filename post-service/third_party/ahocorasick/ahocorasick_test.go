@@ -0,0 +1,78 @@
+package ahocorasick
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	cases := []struct {
+		name  string
+		words []string
+		text  string
+		want  []Match
+	}{
+		{
+			name:  "overlapping",
+			words: []string{"he", "she", "his", "hers"},
+			text:  "ushers",
+			want:  []Match{{1, 4, 1}, {2, 4, 0}, {2, 6, 3}},
+		},
+		{
+			name:  "case folded",
+			words: []string{"he", "SHE", "his", "Hers"},
+			text:  "USHers",
+			want:  []Match{{1, 4, 1}, {2, 4, 0}, {2, 6, 3}},
+		},
+		{
+			name:  "nested",
+			words: []string{"a", "aa", "aaa"},
+			text:  "aaa",
+			want:  []Match{{0, 1, 0}, {0, 2, 1}, {1, 2, 0}, {0, 3, 2}, {1, 3, 1}, {2, 3, 0}},
+		},
+		{
+			name:  "cjk rune offsets",
+			words: []string{"敏感", "感词"},
+			text:  "这是敏感词",
+			want:  []Match{{2, 4, 0}, {3, 5, 1}},
+		},
+		{
+			name:  "duplicate keeps first index",
+			words: []string{"spam", "SPAM"},
+			text:  "Spam",
+			want:  []Match{{0, 4, 0}},
+		},
+		{
+			name:  "empty word ignored",
+			words: []string{"", "x"},
+			text:  "axb",
+			want:  []Match{{1, 2, 1}},
+		},
+		{
+			name:  "fail transition",
+			words: []string{"abcd", "bce"},
+			text:  "abce",
+			want:  []Match{{1, 4, 1}},
+		},
+		{
+			name:  "no match",
+			words: []string{"foo"},
+			text:  "bar",
+			want:  nil,
+		},
+		{
+			name:  "empty text",
+			words: []string{"foo"},
+			text:  "",
+			want:  nil,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := New(c.words).FindAll(c.text)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("FindAll(%q) = %v, want %v", c.text, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package excerpt
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	codeFence  = regexp.MustCompile("(?s)```.*?(```|$)")
+	image      = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	link       = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	htmlTag    = regexp.MustCompile(`<[^>]+>`)
+	linePrefix = regexp.MustCompile(`(?m)^\s*(#{1,6}\s+|>\s?|[-*+]\s+|\d+\.\s+)`)
+	hrule      = regexp.MustCompile(`(?m)^\s*([-*_]\s*){3,}$`)
+	space      = regexp.MustCompile(`\s+`)
+
+	// 只去除成对的强调标记，标记内侧不能是空白，避免误伤 a * b 这样的文本
+	emphasis = []*regexp.Regexp{
+		regexp.MustCompile(`\*\*\*(\S(?:.*?\S)??)\*\*\*`),
+		regexp.MustCompile(`\*\*(\S(?:.*?\S)??)\*\*`),
+		regexp.MustCompile(`\*(\S(?:.*?\S)??)\*`),
+		regexp.MustCompile(`~~(\S(?:.*?\S)??)~~`),
+		regexp.MustCompile("`([^`]+)`"),
+	}
+	// 下划线只在单词边界处生效，snake_case_name这样的标识符保持原样
+	underscore = []*regexp.Regexp{
+		regexp.MustCompile(`(^|[^\p{L}\p{N}_])___(\S(?:.*?\S)??)___([^\p{L}\p{N}_]|$)`),
+		regexp.MustCompile(`(^|[^\p{L}\p{N}_])__(\S(?:.*?\S)??)__([^\p{L}\p{N}_]|$)`),
+		regexp.MustCompile(`(^|[^\p{L}\p{N}_])_(\S(?:.*?\S)??)_([^\p{L}\p{N}_]|$)`),
+	}
+)
+
+const ellipsis = "…"
+
+// Make 去除markdown标记后截取前maxRunes个字符，被截断时以省略号结尾
+// 代码块整体去除，链接与图片只保留文字部分
+func Make(content string, maxRunes int) string {
+	s := codeFence.ReplaceAllString(content, " ")
+	s = image.ReplaceAllString(s, "$1")
+	s = link.ReplaceAllString(s, "$1")
+	s = htmlTag.ReplaceAllString(s, "")
+	s = hrule.ReplaceAllString(s, "")
+	s = linePrefix.ReplaceAllString(s, "")
+	for _, re := range emphasis {
+		s = re.ReplaceAllString(s, "$1")
+	}
+	for _, re := range underscore {
+		s = replaceAllRepeated(re, s, "${1}${2}${3}")
+	}
+	s = strings.TrimSpace(space.ReplaceAllString(s, " "))
+
+	if maxRunes <= 0 || utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	n := 0
+	for i := range s {
+		if n == maxRunes {
+			return strings.TrimSpace(s[:i]) + ellipsis
+		}
+		n++
+	}
+	return s
+}
+
+// replaceAllRepeated 边界字符会被前一次匹配消耗，相邻的标记需要多次替换
+func replaceAllRepeated(re *regexp.Regexp, s, repl string) string {
+	for {
+		replaced := re.ReplaceAllString(s, repl)
+		if replaced == s {
+			return s
+		}
+		s = replaced
+	}
+}
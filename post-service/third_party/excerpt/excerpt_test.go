@@ -0,0 +1,38 @@
+package excerpt
+
+import "testing"
+
+func TestMake(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		max     int
+		want    string
+	}{
+		{"empty", "", 10, ""},
+		{"plain", "hello world", 0, "hello world"},
+		{"heading and quote", "# Title\n> quoted\n- item", 0, "Title quoted item"},
+		{"link and image", "see [docs](http://x) ![logo](a.png)", 0, "see docs logo"},
+		{"code fence", "before\n```go\nfmt.Println()\n```\nafter", 0, "before after"},
+		{"unclosed code fence", "before\n```\ncode", 0, "before"},
+		{"html tag", "<b>bold</b> text", 0, "bold text"},
+		{"strong", "**bold** and ***both***", 0, "bold and both"},
+		{"emphasis", "*em* and ~~del~~ and `code`", 0, "em and del and code"},
+		{"lone asterisk", "a * b * c", 0, "a * b * c"},
+		{"underscore emphasis", "_em_ and __strong__ and ___both___", 0, "em and strong and both"},
+		{"adjacent underscores", "_a_ _b_", 0, "a b"},
+		{"snake case", "call snake_case_name here", 0, "call snake_case_name here"},
+		{"underscore inside word", "foo_bar_ and _baz", 0, "foo_bar_ and _baz"},
+		{"cjk underscore", "中文_强调_文本", 0, "中文_强调_文本"},
+		{"truncate", "你好世界再见", 4, "你好世界…"},
+		{"truncate trims space", "ab cd", 3, "ab…"},
+		{"exact length", "abcd", 4, "abcd"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Make(c.content, c.max); got != c.want {
+				t.Fatalf("Make(%q, %d) = %q, want %q", c.content, c.max, got, c.want)
+			}
+		})
+	}
+}
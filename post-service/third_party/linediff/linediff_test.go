@@ -0,0 +1,85 @@
+package linediff
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// render 将差异转换为"=行"、"-行"、"+行"便于比较
+func render(lines []Line) []string {
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, [...]string{"=", "+", "-"}[l.Op]+l.Text)
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{"both empty", "", "", []string{}},
+		{"old empty", "", "a\nb", []string{"+a", "+b"}},
+		{"new empty", "a\nb\n", "", []string{"-a", "-b"}},
+		{"equal", "a\nb", "a\nb\n", []string{"=a", "=b"}},
+		{"crlf", "a\r\nb\r\n", "a\nb", []string{"=a", "=b"}},
+		{"replace middle", "a\nb\nc", "a\nx\nc", []string{"=a", "-b", "+x", "=c"}},
+		{"insert", "a\nc", "a\nb\nc", []string{"=a", "+b", "=c"}},
+		{"delete", "a\nb\nc", "a\nc", []string{"=a", "-b", "=c"}},
+		{"lcs", "a\nb\nc\nd", "b\nx\nd\ny", []string{"-a", "=b", "-c", "+x", "=d", "+y"}},
+		{"empty line", "a\n\nb", "a\nb", []string{"=a", "-", "=b"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := render(Diff(c.old, c.new)); !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("Diff(%q, %q) = %q, want %q", c.old, c.new, got, c.want)
+			}
+		})
+	}
+}
+
+// sized 生成n行互不相同的文本，中间一行为common
+func sized(prefix string, n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	lines[n/2] = "common"
+	return strings.Join(lines, "\n")
+}
+
+func TestDiffSizeCap(t *testing.T) {
+	cases := []struct {
+		name      string
+		n         int
+		wantEqual int
+	}{
+		{"under cap", 500, 1},
+		{"over cap", 501, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			lines := Diff(sized("a", c.n), sized("b", c.n))
+			if len(lines) != 2*c.n-c.wantEqual {
+				t.Fatalf("got %d lines, want %d", len(lines), 2*c.n-c.wantEqual)
+			}
+			equal := 0
+			for i, l := range lines {
+				if l.Op == Equal {
+					equal++
+				}
+				// 超过规模时先删除全部旧行，再插入全部新行
+				if c.wantEqual == 0 && (l.Op == Delete) != (i < c.n) {
+					t.Fatalf("line %d = %+v, want all deletes before inserts", i, l)
+				}
+			}
+			if equal != c.wantEqual {
+				t.Fatalf("got %d equal lines, want %d", equal, c.wantEqual)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package redislock
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrNotAcquired = errors.New("redislock: lock not acquired")
+	ErrLockLost    = errors.New("redislock: lock lost")
+	ErrTTLTooShort = errors.New("redislock: ttl is too short")
+	ErrFenceStale  = errors.New("redislock: fence token is stale")
+)
+
+// 获取锁的同时递增栅栏计数，计数与锁的key在redis集群中需要位于同一个slot，可以用{}包住key
+// 计数不小于redis的当前毫秒时间，计数过期或redis数据丢失后令牌仍然递增，计数因此可以设置过期时间
+var acquireScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	local t = redis.call('TIME')
+	local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+	local fence = redis.call('INCR', KEYS[2])
+	if fence < now then
+		fence = now
+		redis.call('SET', KEYS[2], fence)
+	end
+	redis.call('PEXPIRE', KEYS[2], ARGV[3])
+	return fence
+end
+return 0`)
+
+// 只有持有者才能续期与释放，避免过期后操作其他人刚获取的锁
+var refreshScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0`)
+
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0`)
+
+const (
+	fenceTTL        = 7 * 24 * time.Hour
+	minTTL          = 30 * time.Millisecond
+	defaultRetryMin = 10 * time.Millisecond
+	defaultRetryMax = 500 * time.Millisecond
+)
+
+type Client struct {
+	rdb      redis.Scripter
+	retryMin time.Duration
+	retryMax time.Duration
+}
+
+func New(rdb redis.Scripter) *Client {
+	return &Client{rdb: rdb, retryMin: defaultRetryMin, retryMax: defaultRetryMax}
+}
+
+// Lock 一个已获取的锁，持有期间由后台协程每ttl/3续期一次
+type Lock struct {
+	client *Client
+	key    string
+	token  string
+	fence  int64
+	ttl    time.Duration
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// TryLock 尝试获取一次锁，锁被占用时返回ErrNotAcquired
+func (c *Client) TryLock(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	if ttl < minTTL {
+		return nil, ErrTTLTooShort
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	fence, err := acquireScript.Run(ctx, c.rdb, []string{key, key + ":fence"}, token, ttl.Milliseconds(), fenceTTL.Milliseconds()).Int64()
+	if err != nil {
+		return nil, err
+	}
+	if fence == 0 {
+		return nil, ErrNotAcquired
+	}
+
+	l := &Lock{
+		client: c,
+		key:    key,
+		token:  token,
+		fence:  fence,
+		ttl:    ttl,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	// 锁的生命周期不受获取时ctx的超时影响，只继承其中的值
+	l.ctx, l.cancel = context.WithCancelCause(context.WithoutCancel(ctx))
+	go l.watchdog(start.Add(ttl))
+	return l, nil
+}
+
+// Lock 阻塞直到获取锁或ctx结束，重试间隔按指数退避并加入随机抖动
+func (c *Client) Lock(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	backoff := c.retryMin
+	for {
+		l, err := c.TryLock(ctx, key, ttl)
+		if !errors.Is(err, ErrNotAcquired) {
+			return l, err
+		}
+
+		wait := backoff/2 + rand.N(backoff/2+1)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, c.retryMax)
+	}
+}
+
+// Fence 单调递增的栅栏令牌，写入外部存储时一并写入并拒绝比已写入的令牌更小的请求
+// 可以防止锁过期后仍在执行的旧持有者覆盖新持有者的写入
+func (l *Lock) Fence() int64 {
+	return l.fence
+}
+
+func (l *Lock) Key() string {
+	return l.key
+}
+
+type fenceCtxKey struct{}
+
+// FenceToken 随ctx传递给写入方的栅栏令牌
+type FenceToken struct {
+	Key   string
+	Token int64
+}
+
+// WithFence 将栅栏令牌放入ctx，写入方通过FenceFromContext取出并校验
+func (l *Lock) WithFence(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fenceCtxKey{}, FenceToken{Key: l.key, Token: l.fence})
+}
+
+func FenceFromContext(ctx context.Context) (FenceToken, bool) {
+	f, ok := ctx.Value(fenceCtxKey{}).(FenceToken)
+	return f, ok
+}
+
+// Context 在锁丢失或释放时取消，持锁执行的任务应使用该ctx
+func (l *Lock) Context() context.Context {
+	return l.ctx
+}
+
+// Unlock 释放锁，锁已过期或被他人持有时返回ErrLockLost
+func (l *Lock) Unlock(ctx context.Context) error {
+	l.once.Do(func() { close(l.stop) })
+	<-l.done
+	l.cancel(context.Canceled)
+
+	n, err := releaseScript.Run(ctx, l.client.rdb, []string{l.key}, l.token).Int64()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// watchdog 定时续期，发现锁已不属于自己，或续期一直失败、下次续期前锁就会过期时取消Context
+func (l *Lock) watchdog(deadline time.Time) {
+	defer close(l.done)
+
+	interval := l.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		// 以发出请求的时间计算过期时间，保守估计锁在redis中的剩余时间
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(l.ctx, interval)
+		n, err := refreshScript.Run(ctx, l.client.rdb, []string{l.key}, l.token, l.ttl.Milliseconds()).Int64()
+		cancel()
+		switch {
+		case err == nil && n == 1:
+			deadline = start.Add(l.ttl)
+		case err == nil || time.Now().Add(interval).After(deadline):
+			l.cancel(ErrLockLost)
+			return
+		}
+	}
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
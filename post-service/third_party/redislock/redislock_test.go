@@ -0,0 +1,245 @@
+package redislock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestClient(t *testing.T) (*Client, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return New(rdb), mr
+}
+
+func TestTryLockContention(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	if _, err := c.TryLock(ctx, "job", time.Second); !errors.Is(err, ErrNotAcquired) {
+		t.Fatalf("second TryLock err = %v, want ErrNotAcquired", err)
+	}
+	if err := l.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+
+	l2, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock after Unlock: %v", err)
+	}
+	defer l2.Unlock(ctx)
+}
+
+func TestTryLockTTLTooShort(t *testing.T) {
+	c, _ := newTestClient(t)
+	if _, err := c.TryLock(context.Background(), "job", time.Millisecond); !errors.Is(err, ErrTTLTooShort) {
+		t.Fatalf("err = %v, want ErrTTLTooShort", err)
+	}
+}
+
+func TestUnlockLost(t *testing.T) {
+	c, mr := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	// 锁过期后被其他持有者获取，旧持有者释放时不能删除新持有者的锁
+	mr.FastForward(2 * time.Second)
+	other, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock by other: %v", err)
+	}
+	defer other.Unlock(ctx)
+
+	if err := l.Unlock(ctx); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("Unlock err = %v, want ErrLockLost", err)
+	}
+	if !mr.Exists("job") {
+		t.Fatal("lock held by other was deleted")
+	}
+}
+
+func TestWatchdogRenews(t *testing.T) {
+	c, mr := newTestClient(t)
+	ctx := context.Background()
+
+	ttl := 300 * time.Millisecond
+	l, err := c.TryLock(ctx, "job", ttl)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	defer l.Unlock(ctx)
+
+	// miniredis只在FastForward时推进过期时间，剩余时间恢复为ttl说明已续期
+	mr.FastForward(200 * time.Millisecond)
+	deadline := time.Now().Add(2 * time.Second)
+	for mr.TTL("job") != ttl {
+		if time.Now().After(deadline) {
+			t.Fatalf("lock not renewed, ttl = %v", mr.TTL("job"))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := l.Context().Err(); err != nil {
+		t.Fatalf("lock context canceled while renewing: %v", err)
+	}
+}
+
+func TestWatchdogCancelsContextWhenLost(t *testing.T) {
+	c, mr := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", 90*time.Millisecond)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	mr.Set("job", "someone-else")
+
+	select {
+	case <-l.Context().Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("lock context not canceled after the lock was lost")
+	}
+	if cause := context.Cause(l.Context()); !errors.Is(cause, ErrLockLost) {
+		t.Fatalf("cause = %v, want ErrLockLost", cause)
+	}
+	if err := l.Unlock(ctx); !errors.Is(err, ErrLockLost) {
+		t.Fatalf("Unlock err = %v, want ErrLockLost", err)
+	}
+}
+
+func TestUnlockCancelsContext(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	if err := l.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if !errors.Is(l.Context().Err(), context.Canceled) {
+		t.Fatalf("lock context err = %v, want context.Canceled", l.Context().Err())
+	}
+}
+
+func TestLockWaitsForRelease(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		l.Unlock(ctx)
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	l2, err := c.Lock(waitCtx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("Lock: %v", err)
+	}
+	defer l2.Unlock(ctx)
+}
+
+func TestLockHonoursContext(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	defer l.Unlock(ctx)
+
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := c.Lock(waitCtx, "job", time.Second); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Lock err = %v, want context.DeadlineExceeded", err)
+	}
+	// 退避间隔最大为retryMax，ctx结束后应立即返回而不是等待下一次重试
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond+defaultRetryMax/2 {
+		t.Fatalf("Lock returned %v after ctx deadline", elapsed)
+	}
+}
+
+func TestFenceIncreases(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	var last int64
+	for i := 0; i < 5; i++ {
+		l, err := c.TryLock(ctx, "job", time.Second)
+		if err != nil {
+			t.Fatalf("TryLock: %v", err)
+		}
+		if l.Fence() <= last {
+			t.Fatalf("fence %d is not greater than %d", l.Fence(), last)
+		}
+		last = l.Fence()
+		if err := l.Unlock(ctx); err != nil {
+			t.Fatalf("Unlock: %v", err)
+		}
+	}
+}
+
+func TestFenceSurvivesCounterLoss(t *testing.T) {
+	c, mr := newTestClient(t)
+	ctx := context.Background()
+
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	if err := l.Unlock(ctx); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if ttl := mr.TTL("job:fence"); ttl <= 0 {
+		t.Fatalf("fence counter ttl = %v, want positive", ttl)
+	}
+
+	// 计数丢失后新令牌仍然不小于旧令牌
+	mr.Del("job:fence")
+	l2, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	defer l2.Unlock(ctx)
+	if l2.Fence() < l.Fence() {
+		t.Fatalf("fence %d went back from %d", l2.Fence(), l.Fence())
+	}
+}
+
+func TestWithFence(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	if _, ok := FenceFromContext(ctx); ok {
+		t.Fatal("FenceFromContext found a fence in a bare context")
+	}
+	l, err := c.TryLock(ctx, "job", time.Second)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	defer l.Unlock(ctx)
+	f, ok := FenceFromContext(l.WithFence(ctx))
+	if !ok || f.Key != "job" || f.Token != l.Fence() {
+		t.Fatalf("fence = %+v, %v, want {job %d}", f, ok, l.Fence())
+	}
+}
@@ -0,0 +1,135 @@
+package segment
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenizer 为全文检索切分文本，切分结果写入pg中以空格分隔的索引列
+// 可以替换为基于词典的分词实现，只需保证写入与查询使用同一个实现
+type Tokenizer interface {
+	// Segment 在词与词之间插入空格，其余文本保持不变
+	Segment(text string) string
+	// Restore 去除Segment插入的空格，用于展示高亮片段
+	Restore(segmented string) string
+	// Terms 将查询切分为多个词组，词组内的词需要按顺序相邻出现
+	Terms(query string) [][]string
+}
+
+// Unigram 将每个汉字作为一个词，其他文字按非字母数字字符切分
+// 不依赖词典，查询时使用相邻匹配保证准确率
+type Unigram struct{}
+
+func NewUnigram() *Unigram {
+	return &Unigram{}
+}
+
+func (Unigram) Segment(text string) string {
+	var b strings.Builder
+	b.Grow(len(text) * 2)
+	prevHan := false
+	for _, r := range text {
+		han := unicode.Is(unicode.Han, r)
+		if han || prevHan {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+		prevHan = han
+	}
+	return b.String()
+}
+
+// Restore 按Segment的规则去除插入的空格：汉字之前与汉字之后各插入了一个空格，原文中的空格保持不变
+// 高亮标记不影响判断，片段开头缺少插入的空格时也能还原
+func (Unigram) Restore(segmented string) string {
+	runes := []rune(segmented)
+	var b strings.Builder
+	b.Grow(len(segmented))
+	prevHan, inserted := false, false
+	for i := 0; i < len(runes); i++ {
+		if k := markerLen(runes[i:]); k > 0 {
+			b.WriteString(string(runes[i : i+k]))
+			i += k - 1
+			continue
+		}
+		r := runes[i]
+		// 插入的空格之后一定是原文中的字符
+		if r == ' ' && !inserted && (prevHan || isHan(nextRune(runes, i))) {
+			inserted = true
+			continue
+		}
+		b.WriteRune(r)
+		prevHan, inserted = isHan(r), false
+	}
+	// 相邻的高亮片段合并为一个
+	return strings.ReplaceAll(b.String(), "</em><em>", "")
+}
+
+func (Unigram) Terms(query string) [][]string {
+	terms := make([][]string, 0)
+	word := make([]rune, 0)
+	han := make([]string, 0)
+	flushWord := func() {
+		if len(word) != 0 {
+			terms = append(terms, []string{strings.ToLower(string(word))})
+			word = word[:0]
+		}
+	}
+	flushHan := func() {
+		if len(han) != 0 {
+			terms = append(terms, han)
+			han = make([]string, 0)
+		}
+	}
+	for _, r := range query {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return terms
+}
+
+func isHan(r rune) bool {
+	return r != 0 && unicode.Is(unicode.Han, r)
+}
+
+// markerLen 返回以runes开头的高亮标记的长度，不是标记时返回0
+func markerLen(runes []rune) int {
+	if len(runes) == 0 || runes[0] != '<' {
+		return 0
+	}
+	return index(runes, '>') + 1
+}
+
+// nextRune 返回i之后第一个不属于高亮标记的字符
+func nextRune(runes []rune, i int) rune {
+	for j := i + 1; j < len(runes); j++ {
+		if runes[j] == '<' {
+			if k := index(runes[j:], '>'); k >= 0 {
+				j += k
+				continue
+			}
+		}
+		return runes[j]
+	}
+	return 0
+}
+
+func index(runes []rune, r rune) int {
+	for i, v := range runes {
+		if v == r {
+			return i
+		}
+	}
+	return -1
+}
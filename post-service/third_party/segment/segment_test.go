@@ -0,0 +1,111 @@
+package segment
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	cases := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"hello world", "hello world"},
+		{"中文", " 中 文"},
+		{"ab中文cd", "ab 中 文 cd"},
+		{"中 a", " 中  a"},
+	}
+	u := NewUnigram()
+	for _, c := range cases {
+		if got := u.Segment(c.text); got != c.want {
+			t.Fatalf("Segment(%q) = %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestRestoreRoundTrip(t *testing.T) {
+	cases := []string{
+		"",
+		"hello world",
+		"全文检索",
+		"ab中文cd",
+		"Go语言 入门",
+		"中 a",
+		"  两个  空格  ",
+		"标点，符号。",
+		"a&lt;b中",
+	}
+	u := NewUnigram()
+	for _, text := range cases {
+		if got := u.Restore(u.Segment(text)); got != text {
+			t.Fatalf("Restore(Segment(%q)) = %q", text, got)
+		}
+	}
+}
+
+func TestRestoreHighlight(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		hit  []string // 模拟ts_headline用<em>包住命中的词
+		want string
+	}{
+		{"single han", "全文检索", []string{"检"}, "全文<em>检</em>索"},
+		{"adjacent han merged", "全文检索", []string{"检", "索"}, "全文<em>检索</em>"},
+		{"word", "Go语言入门", []string{"go"}, "<em>Go</em>语言入门"},
+		{"han after word", "ab中文cd", []string{"中", "文"}, "ab<em>中文</em>cd"},
+		{"word after han", "中文cd", []string{"cd"}, "中文<em>cd</em>"},
+		{"keeps original space", "中 a", []string{"中", "a"}, "<em>中</em> <em>a</em>"},
+	}
+	u := NewUnigram()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := u.Restore(highlight(u.Segment(c.text), c.hit))
+			if got != c.want {
+				t.Fatalf("Restore = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+// TestRestoreFragment ts_headline截取的片段可能不带开头插入的空格
+func TestRestoreFragment(t *testing.T) {
+	u := NewUnigram()
+	if got := u.Restore("中 文 cd"); got != "中文cd" {
+		t.Fatalf("Restore = %q, want %q", got, "中文cd")
+	}
+}
+
+func TestTerms(t *testing.T) {
+	cases := []struct {
+		query string
+		want  [][]string
+	}{
+		{"", [][]string{}},
+		{"Hello, World", [][]string{{"hello"}, {"world"}}},
+		{"全文检索", [][]string{{"全", "文", "检", "索"}}},
+		{"Go语言 入门", [][]string{{"go"}, {"语", "言"}, {"入", "门"}}},
+		{"a-b 中", [][]string{{"a"}, {"b"}, {"中"}}},
+	}
+	u := NewUnigram()
+	for _, c := range cases {
+		if got := u.Terms(c.query); !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("Terms(%q) = %q, want %q", c.query, got, c.want)
+		}
+	}
+}
+
+// highlight 按空格切分后为命中的词加上<em>标记，空格原样保留
+func highlight(segmented string, hits []string) string {
+	words := strings.Split(segmented, " ")
+	for i, w := range words {
+		for _, hit := range hits {
+			if w != "" && strings.EqualFold(w, hit) {
+				words[i] = "<em>" + w + "</em>"
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -20,14 +20,17 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationAuthLogin = "/auth.v1.Auth/Login"
+const OperationAuthVerifyTOTP = "/auth.v1.Auth/VerifyTOTP"
 
 type AuthHTTPServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	VerifyTOTP(context.Context, *VerifyTOTPRequest) (*LoginResponse, error)
 }
 
 func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
 	r := s.Route("/")
 	r.POST("/login", _Auth_Login0_HTTP_Handler(srv))
+	r.POST("/login/totp", _Auth_VerifyTOTP0_HTTP_Handler(srv))
 }
 
 func _Auth_Login0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
@@ -52,8 +55,31 @@ func _Auth_Login0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _Auth_VerifyTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthVerifyTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyTOTP(ctx, req.(*VerifyTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthHTTPClient interface {
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	VerifyTOTP(ctx context.Context, req *VerifyTOTPRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 }
 
 type AuthHTTPClientImpl struct {
@@ -76,3 +102,16 @@ func (c *AuthHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts .
 	}
 	return &out, nil
 }
+
+func (c *AuthHTTPClientImpl) VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/login/totp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthVerifyTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,1237 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/user/v1/user.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetUserByUIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByUIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByUIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByUIDRequestMultiError, or nil if none found.
+func (m *GetUserByUIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByUIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	if len(errors) > 0 {
+		return GetUserByUIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByUIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserByUIDRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserByUIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByUIDRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByUIDRequestMultiError) AllErrors() []error { return m }
+
+// GetUserByUIDRequestValidationError is the validation error returned by
+// GetUserByUIDRequest.Validate if the designated constraints aren't met.
+type GetUserByUIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByUIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByUIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByUIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByUIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByUIDRequestValidationError) ErrorName() string {
+	return "GetUserByUIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByUIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByUIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByUIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByUIDRequestValidationError{}
+
+// Validate checks the field values on GetUserByUIDReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByUIDReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByUIDReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByUIDReplyMultiError, or nil if none found.
+func (m *GetUserByUIDReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByUIDReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uid
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserByUIDReplyValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserByUIDReplyValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserByUIDReplyValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserByUIDReplyValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserByUIDReplyValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserByUIDReplyValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return GetUserByUIDReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByUIDReplyMultiError is an error wrapping multiple validation errors
+// returned by GetUserByUIDReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserByUIDReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByUIDReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByUIDReplyMultiError) AllErrors() []error { return m }
+
+// GetUserByUIDReplyValidationError is the validation error returned by
+// GetUserByUIDReply.Validate if the designated constraints aren't met.
+type GetUserByUIDReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByUIDReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByUIDReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByUIDReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByUIDReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByUIDReplyValidationError) ErrorName() string {
+	return "GetUserByUIDReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByUIDReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByUIDReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByUIDReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByUIDReplyValidationError{}
+
+// Validate checks the field values on GetProfileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileRequestMultiError, or nil if none found.
+func (m *GetProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	if len(errors) > 0 {
+		return GetProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProfileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetProfileRequestValidationError is the validation error returned by
+// GetProfileRequest.Validate if the designated constraints aren't met.
+type GetProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileRequestValidationError) ErrorName() string {
+	return "GetProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileRequestValidationError{}
+
+// Validate checks the field values on GetProfileReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProfileReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProfileReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProfileReplyMultiError, or nil if none found.
+func (m *GetProfileReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProfileReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uid
+
+	// no validation rules for Username
+
+	// no validation rules for Phone
+
+	// no validation rules for Gender
+
+	// no validation rules for Points
+
+	if all {
+		switch v := interface{}(m.GetCreateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "CreateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileReplyValidationError{
+				field:  "CreateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "UpdateAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileReplyValidationError{
+				field:  "UpdateAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBirthday()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "Birthday",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetProfileReplyValidationError{
+					field:  "Birthday",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBirthday()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetProfileReplyValidationError{
+				field:  "Birthday",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetProfileReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProfileReplyMultiError is an error wrapping multiple validation errors
+// returned by GetProfileReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetProfileReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProfileReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProfileReplyMultiError) AllErrors() []error { return m }
+
+// GetProfileReplyValidationError is the validation error returned by
+// GetProfileReply.Validate if the designated constraints aren't met.
+type GetProfileReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProfileReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProfileReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProfileReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProfileReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProfileReplyValidationError) ErrorName() string { return "GetProfileReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetProfileReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProfileReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProfileReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProfileReplyValidationError{}
+
+// Validate checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersRequestMultiError, or nil if none found.
+func (m *BatchGetUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUids()); l < 1 || l > 100 {
+		err := BatchGetUsersRequestValidationError{
+			field:  "Uids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersRequestValidationError is the validation error returned by
+// BatchGetUsersRequest.Validate if the designated constraints aren't met.
+type BatchGetUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersRequestValidationError) ErrorName() string {
+	return "BatchGetUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersRequestValidationError{}
+
+// Validate checks the field values on BatchGetUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersReplyMultiError, or nil if none found.
+func (m *BatchGetUsersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetUsersReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetUsersReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchGetUsersReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGetUsersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersReplyValidationError is the validation error returned by
+// BatchGetUsersReply.Validate if the designated constraints aren't met.
+type BatchGetUsersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersReplyValidationError) ErrorName() string {
+	return "BatchGetUsersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersReplyValidationError{}
+
+// Validate checks the field values on PublicProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublicProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublicProfileMultiError, or
+// nil if none found.
+func (m *PublicProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for Username
+
+	// no validation rules for Avatar
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return PublicProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicProfileMultiError is an error wrapping multiple validation errors
+// returned by PublicProfile.ValidateAll() if the designated constraints
+// aren't met.
+type PublicProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicProfileMultiError) AllErrors() []error { return m }
+
+// PublicProfileValidationError is the validation error returned by
+// PublicProfile.Validate if the designated constraints aren't met.
+type PublicProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicProfileValidationError) ErrorName() string { return "PublicProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicProfileValidationError{}
+
+// Validate checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKeyword()); l < 1 || l > 16 {
+		err := SearchUsersRequestValidationError{
+			field:  "Keyword",
+			reason: "value length must be between 1 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := SearchUsersRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 50 {
+		err := SearchUsersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchUsersRequestValidationError{}
+
+// Validate checks the field values on SearchUsersReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchUsersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersReplyMultiError, or nil if none found.
+func (m *SearchUsersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchUsersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchUsersReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchUsersReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for HasMore
+
+	if len(errors) > 0 {
+		return SearchUsersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchUsersReplyMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersReply.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchUsersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchUsersReplyMultiError) AllErrors() []error { return m }
+
+// SearchUsersReplyValidationError is the validation error returned by
+// SearchUsersReply.Validate if the designated constraints aren't met.
+type SearchUsersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchUsersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchUsersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchUsersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchUsersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchUsersReplyValidationError) ErrorName() string { return "SearchUsersReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchUsersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchUsersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchUsersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchUsersReplyValidationError{}
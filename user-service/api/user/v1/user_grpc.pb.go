@@ -24,6 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type UserClient interface {
 	GetUserByUID(ctx context.Context, in *GetUserByUIDRequest, opts ...grpc.CallOption) (*GetUserByUIDReply, error)
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileReply, error)
+	// 批量获取用户公开信息，用于渲染帖子列表等场景
+	BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersReply, error)
+	// 按用户名搜索用户，前缀匹配的结果排在模糊匹配之前
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersReply, error)
 }
 
 type userClient struct {
@@ -52,12 +56,34 @@ func (c *userClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts
 	return out, nil
 }
 
+func (c *userClient) BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersReply, error) {
+	out := new(BatchGetUsersReply)
+	err := c.cc.Invoke(ctx, "/api.user.v1.User/BatchGetUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersReply, error) {
+	out := new(SearchUsersReply)
+	err := c.cc.Invoke(ctx, "/api.user.v1.User/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
 type UserServer interface {
 	GetUserByUID(context.Context, *GetUserByUIDRequest) (*GetUserByUIDReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
+	// 批量获取用户公开信息，用于渲染帖子列表等场景
+	BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersReply, error)
+	// 按用户名搜索用户，前缀匹配的结果排在模糊匹配之前
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -71,6 +97,12 @@ func (UnimplementedUserServer) GetUserByUID(context.Context, *GetUserByUIDReques
 func (UnimplementedUserServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
+func (UnimplementedUserServer) BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetUsers not implemented")
+}
+func (UnimplementedUserServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +152,42 @@ func _User_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_BatchGetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BatchGetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.user.v1.User/BatchGetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BatchGetUsers(ctx, req.(*BatchGetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.user.v1.User/SearchUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +203,14 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfile",
 			Handler:    _User_GetProfile_Handler,
 		},
+		{
+			MethodName: "BatchGetUsers",
+			Handler:    _User_BatchGetUsers_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _User_SearchUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/user/v1/user.proto",
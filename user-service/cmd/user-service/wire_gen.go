@@ -34,8 +34,12 @@ func wireApp(confServer *conf.Server, confBiz *conf.Biz, confData *conf.Data, lo
 	node := biz.NewSfNode(confBiz)
 	authUsecase := biz.NewAuthUsecase(authRepo, confBiz, node, logger)
 	authService := service.NewAuthService(authUsecase)
-	grpcServer := server.NewGRPCServer(confServer, authService, logger)
-	httpServer := server.NewHTTPServer(confServer, authService, logger)
+	userRepo := data.NewUserRepo(dataData, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, logger)
+	userService := service.NewUserService(userUsecase)
+	grpcServer := server.NewGRPCServer(confServer, confBiz, authService, userService, authUsecase, logger)
+	oAuthService := service.NewOAuthService(authUsecase)
+	httpServer := server.NewHTTPServer(confServer, confBiz, authService, oAuthService, authUsecase, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
 		cleanup()
@@ -9,6 +9,7 @@ import (
 	"user-service/internal/model"
 	"user-service/third_party/encrypt"
 	"user-service/third_party/jwt"
+	"user-service/third_party/oidc"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/go-kratos/kratos/v2/log"
@@ -18,6 +19,31 @@ type AuthRepo interface {
 	GetLogInfoByUserName(ctx context.Context, username string) (*model.LoginInfo, error)
 
 	SaveLoginInfo(ctx context.Context, loginInfo *model.LoginInfo) error
+
+	GetLogInfoByUID(ctx context.Context, uid int64) (*model.LoginInfo, error)
+	GetTOTPInfo(ctx context.Context, uid int64) (*model.TOTPInfo, error)
+	SaveTOTPSecret(ctx context.Context, uid int64, secret string) error
+	EnableTOTP(ctx context.Context, uid int64, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, uid int64, codeHash string) (bool, error)
+
+	SaveTOTPChallenge(ctx context.Context, challenge string, uid int64, ttl time.Duration) error
+	GetTOTPChallenge(ctx context.Context, challenge string) (int64, error)
+	DelTOTPChallenge(ctx context.Context, challenge string) error
+	IncrTOTPAttempt(ctx context.Context, challenge string, ttl time.Duration) (int64, error)
+	MarkTOTPUsed(ctx context.Context, uid, step int64, ttl time.Duration) (bool, error)
+
+	SaveSession(ctx context.Context, s *model.Session) error
+	GetSession(ctx context.Context, sid string) (*model.Session, error)
+	ListSessions(ctx context.Context, uid int64) ([]*model.Session, error)
+	RevokeSession(ctx context.Context, uid int64, sid string) (bool, error)
+	TouchSession(ctx context.Context, sid string) (bool, error)
+	RestoreSession(ctx context.Context, s *model.Session) (bool, error)
+
+	GetIdentity(ctx context.Context, provider, subject string) (*model.Identity, error)
+	SaveIdentity(ctx context.Context, identity *model.Identity) error
+	ProvisionOAuthUser(ctx context.Context, loginInfo *model.LoginInfo, identity *model.Identity) error
+	SaveOAuthState(ctx context.Context, state string, s *model.OAuthState, ttl time.Duration) error
+	TakeOAuthState(ctx context.Context, state string) (*model.OAuthState, error)
 }
 
 type AuthUsecase struct {
@@ -26,16 +52,32 @@ type AuthUsecase struct {
 
 	pwdSecrete []byte
 	jwtSecrete []byte
+	totpIssuer string
+
+	providers map[string]*oidc.Provider
 
 	sfNode *snowflake.Node
 }
 
 func NewAuthUsecase(repo AuthRepo, c *conf.Biz, sfNode *snowflake.Node, logger log.Logger) *AuthUsecase {
+	providers := make(map[string]*oidc.Provider, len(c.Oidc))
+	for _, p := range c.Oidc {
+		providers[p.Name] = oidc.New(oidc.Config{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientId,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectUrl,
+			Scopes:       p.Scopes,
+		})
+	}
 	return &AuthUsecase{
 		repo:       repo,
 		log:        log.NewHelper(logger),
 		pwdSecrete: []byte(c.Auth.PwdSecrete),
 		jwtSecrete: []byte(c.Auth.JwtSecrete),
+		totpIssuer: c.Auth.TotpIssuer,
+		providers:  providers,
 		sfNode:     sfNode,
 	}
 }
@@ -85,8 +127,51 @@ func (uc *AuthUsecase) Login(ctx context.Context, param *model.LoginParam) (*mod
 		}, common.ErrLoginFail
 	}
 
+	return uc.finishLogin(ctx, loginInfo.UID, param.Device)
+}
+
+// finishLogin 完成第一因素校验后的登录流程
+// 开启了二次验证的用户，需要凭挑战通过VerifyTOTP换取JWT
+func (uc *AuthUsecase) finishLogin(ctx context.Context, uid int64, device *model.DeviceInfo) (*model.Response, error) {
+	totpInfo, err := uc.repo.GetTOTPInfo(ctx, uid)
+	if err != nil && !errors.Is(err, common.ErrSQLNotFound) {
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+	if totpInfo != nil && totpInfo.Enabled {
+		challenge, err := uc.newTOTPChallenge(ctx, uid)
+		if err != nil {
+			return &model.Response{
+				Code: common.CodeInternalErr,
+				Data: nil,
+			}, err
+		}
+		return &model.Response{
+			Code: common.CodeNeedTOTP,
+			Data: &challenge,
+		}, nil
+	}
+
 	// 登录成功,获取JWT
-	token, err := jwt.New(uc.jwtSecrete, jwt.WithUID(loginInfo.UID))
+	return uc.issueToken(ctx, uid, device)
+}
+
+// issueToken 为通过全部校验的用户创建会话并签发JWT
+func (uc *AuthUsecase) issueToken(ctx context.Context, uid int64, device *model.DeviceInfo) (*model.Response, error) {
+	session, err := uc.newSession(ctx, uid, device)
+	if err != nil {
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+	token, err := jwt.New(uc.jwtSecrete,
+		jwt.WithUID(uid),
+		jwt.WithSID(session.SID),
+		jwt.WithExpireAt(session.ExpireAt),
+	)
 	if err != nil {
 		return &model.Response{
 			Code: common.CodeInternalErr,
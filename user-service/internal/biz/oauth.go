@@ -0,0 +1,271 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+	"user-service/third_party/encrypt"
+	"user-service/third_party/oidc"
+)
+
+const (
+	OAuthStateTTL      = 10 * time.Minute // 同时作为浏览器绑定cookie的有效期
+	userNameBaseMaxLen = 10               // 预留"_"与5位随机数字，总长度不超过16
+	userNameMinLen     = 6
+	userNameMaxTry     = 5
+)
+
+var userNameFilter = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// OAuthLoginURL 发起第三方登录，返回身份提供方的授权地址与浏览器绑定值
+// linkUID非0时回调会将第三方身份绑定到该用户，uid随签名后的state传递
+// 绑定值需写入发起方浏览器的cookie，回调时校验，防止授权地址被转发给他人完成
+func (uc *AuthUsecase) OAuthLoginURL(ctx context.Context, provider string, linkUID int64) (string, string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", "", common.ErrOAuthProvider
+	}
+
+	state := &model.OAuthState{Provider: provider}
+	stateKey, err := oidc.RandomString()
+	if err != nil {
+		return "", "", err
+	}
+	binding, err := oidc.RandomString()
+	if err != nil {
+		return "", "", err
+	}
+	state.BindingHash = encrypt.SHA256([]byte(binding), nil)
+	if state.Verifier, err = oidc.RandomString(); err != nil {
+		return "", "", err
+	}
+	if state.Nonce, err = oidc.RandomString(); err != nil {
+		return "", "", err
+	}
+	if err := uc.repo.SaveOAuthState(ctx, stateKey, state, OAuthStateTTL); err != nil {
+		uc.log.Errorw(
+			"[biz]", "OAuthLoginURL/SaveOAuthState failed",
+			"err", err,
+		)
+		return "", "", err
+	}
+	authURL, err := p.AuthCodeURL(ctx, uc.signOAuthState(stateKey, linkUID), state.Nonce, state.Verifier)
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, binding, nil
+}
+
+// OAuthLinkURL 为当前登录的用户发起第三方账号绑定
+func (uc *AuthUsecase) OAuthLinkURL(ctx context.Context, provider string) (string, string, error) {
+	uid, err := GetUIDFromCtx(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return uc.OAuthLoginURL(ctx, provider, uid)
+}
+
+// signOAuthState 生成"key.uid.签名"格式的state，回调时据此取回绑定的用户
+func (uc *AuthUsecase) signOAuthState(stateKey string, linkUID int64) string {
+	payload := stateKey + "." + strconv.FormatInt(linkUID, 10)
+	return payload + "." + uc.oauthStateMAC(payload)
+}
+
+// parseOAuthState 校验state签名，返回redis中的state key与绑定的用户
+func (uc *AuthUsecase) parseOAuthState(state string) (string, int64, error) {
+	i := strings.LastIndexByte(state, '.')
+	if i < 0 {
+		return "", 0, common.ErrOAuthState
+	}
+	payload, sig := state[:i], state[i+1:]
+	if !hmac.Equal([]byte(sig), []byte(uc.oauthStateMAC(payload))) {
+		return "", 0, common.ErrOAuthState
+	}
+	stateKey, uidStr, ok := strings.Cut(payload, ".")
+	if !ok {
+		return "", 0, common.ErrOAuthState
+	}
+	linkUID, err := strconv.ParseInt(uidStr, 10, 64)
+	if err != nil {
+		return "", 0, common.ErrOAuthState
+	}
+	return stateKey, linkUID, nil
+}
+
+func (uc *AuthUsecase) oauthStateMAC(payload string) string {
+	// 与jwt共用密钥，加上前缀与jwt的签名区分
+	mac := hmac.New(sha256.New, uc.jwtSecrete)
+	mac.Write([]byte("oauth_state:"))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// OAuthCallback 处理身份提供方的回调，换取并校验id_token后登录对应的本地用户
+// 首次登录的第三方身份会自动创建本地用户
+func (uc *AuthUsecase) OAuthCallback(ctx context.Context, param *model.OAuthCallbackParam) (*model.Response, error) {
+	p, ok := uc.providers[param.Provider]
+	if !ok {
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, common.ErrOAuthProvider
+	}
+	stateKey, linkUID, err := uc.parseOAuthState(param.State)
+	if err != nil {
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, err
+	}
+	state, err := uc.repo.TakeOAuthState(ctx, stateKey)
+	if err != nil || state.Provider != param.Provider {
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, common.ErrOAuthState
+	}
+	// 回调必须来自发起登录的浏览器
+	if param.Binding == "" ||
+		!hmac.Equal([]byte(encrypt.SHA256([]byte(param.Binding), nil)), []byte(state.BindingHash)) {
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, common.ErrOAuthBinding
+	}
+
+	token, err := p.Exchange(ctx, param.Code, state.Verifier)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "OAuthCallback/Exchange failed",
+			"err", err,
+			"provider", param.Provider,
+		)
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, err
+	}
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, state.Nonce)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "OAuthCallback/VerifyIDToken failed",
+			"err", err,
+			"provider", param.Provider,
+		)
+		return &model.Response{
+			Code: common.CodeOAuthFailed,
+			Data: nil,
+		}, err
+	}
+
+	var uid int64
+	identity, err := uc.repo.GetIdentity(ctx, param.Provider, claims.Subject)
+	switch {
+	case err == nil:
+		if linkUID != 0 && linkUID != identity.UID {
+			return &model.Response{
+				Code: common.CodeOAuthFailed,
+				Data: nil,
+			}, common.ErrIdentityLinked
+		}
+		uid = identity.UID
+	case errors.Is(err, common.ErrSQLNotFound):
+		identity = &model.Identity{
+			UID:      linkUID,
+			Provider: param.Provider,
+			Subject:  claims.Subject,
+			Email:    claims.Email,
+		}
+		if linkUID != 0 {
+			err = uc.repo.SaveIdentity(ctx, identity)
+		} else {
+			err = uc.provisionOAuthUser(ctx, identity, claims)
+		}
+		if err != nil {
+			return &model.Response{
+				Code: common.CodeInternalErr,
+				Data: nil,
+			}, err
+		}
+		uid = identity.UID
+	default:
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+
+	return uc.finishLogin(ctx, uid, param.Device)
+}
+
+// provisionOAuthUser 为第三方身份创建本地用户，该用户没有可用的密码
+func (uc *AuthUsecase) provisionOAuthUser(ctx context.Context, identity *model.Identity, claims *oidc.IDClaims) error {
+	userName, err := uc.pickUserName(ctx, claims)
+	if err != nil {
+		return err
+	}
+	randPwd, err := oidc.RandomString()
+	if err != nil {
+		return err
+	}
+	identity.UID = uc.sfNode.Generate().Int64()
+	loginInfo := &model.LoginInfo{
+		UID:       identity.UID,
+		UserName:  userName,
+		SecretPwd: encrypt.SHA256([]byte(randPwd), uc.pwdSecrete),
+	}
+	if err := uc.repo.ProvisionOAuthUser(ctx, loginInfo, identity); err != nil {
+		uc.log.Errorw(
+			"[biz]", "provisionOAuthUser/ProvisionOAuthUser failed",
+			"err", err,
+			"provider", identity.Provider,
+		)
+		return err
+	}
+	return nil
+}
+
+// pickUserName 根据id_token中的信息生成符合注册规则且未被占用的用户名
+func (uc *AuthUsecase) pickUserName(ctx context.Context, claims *oidc.IDClaims) (string, error) {
+	base := claims.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(claims.Email, "@")
+	}
+	base = userNameFilter.ReplaceAllString(base, "")
+	if base == "" {
+		base = "user"
+	}
+	if len(base) > userNameBaseMaxLen {
+		base = base[:userNameBaseMaxLen]
+	}
+
+	for i := range userNameMaxTry {
+		name := base
+		if i > 0 || len(name) < userNameMinLen {
+			n, err := rand.Int(rand.Reader, big.NewInt(100000))
+			if err != nil {
+				return "", err
+			}
+			name = fmt.Sprintf("%s_%05d", base, n.Int64())
+		}
+		_, err := uc.repo.GetLogInfoByUserName(ctx, name)
+		if errors.Is(err, common.ErrSQLNotFound) {
+			return name, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", common.ErrUserNameExhaust
+}
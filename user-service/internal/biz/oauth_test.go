@@ -0,0 +1,316 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/conf"
+	"user-service/internal/model"
+	"user-service/third_party/jwt"
+	"user-service/third_party/oidc/oidctest"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/log"
+	jwtkratos "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+)
+
+// fakeOAuthRepo 只实现第三方登录用到的方法，其余方法调用时panic
+type fakeOAuthRepo struct {
+	AuthRepo
+
+	mu         sync.Mutex
+	states     map[string]*model.OAuthState
+	identities map[string]*model.Identity
+	users      map[string]*model.LoginInfo
+	sessions   []*model.Session
+}
+
+func newFakeOAuthRepo() *fakeOAuthRepo {
+	return &fakeOAuthRepo{
+		states:     make(map[string]*model.OAuthState),
+		identities: make(map[string]*model.Identity),
+		users:      make(map[string]*model.LoginInfo),
+	}
+}
+
+func (r *fakeOAuthRepo) SaveOAuthState(ctx context.Context, state string, s *model.OAuthState, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[state] = s
+	return nil
+}
+
+func (r *fakeOAuthRepo) TakeOAuthState(ctx context.Context, state string) (*model.OAuthState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.states[state]
+	if !ok {
+		return nil, common.ErrOAuthState
+	}
+	delete(r.states, state)
+	return s, nil
+}
+
+func (r *fakeOAuthRepo) GetIdentity(ctx context.Context, provider, subject string) (*model.Identity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	identity, ok := r.identities[provider+":"+subject]
+	if !ok {
+		return nil, common.ErrSQLNotFound
+	}
+	return identity, nil
+}
+
+func (r *fakeOAuthRepo) SaveIdentity(ctx context.Context, identity *model.Identity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.identities[identity.Provider+":"+identity.Subject] = identity
+	return nil
+}
+
+func (r *fakeOAuthRepo) ProvisionOAuthUser(ctx context.Context, loginInfo *model.LoginInfo, identity *model.Identity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.users[loginInfo.UserName] = loginInfo
+	r.identities[identity.Provider+":"+identity.Subject] = identity
+	return nil
+}
+
+func (r *fakeOAuthRepo) GetLogInfoByUserName(ctx context.Context, username string) (*model.LoginInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	info, ok := r.users[username]
+	if !ok {
+		return nil, common.ErrSQLNotFound
+	}
+	return info, nil
+}
+
+func (r *fakeOAuthRepo) GetTOTPInfo(ctx context.Context, uid int64) (*model.TOTPInfo, error) {
+	return nil, common.ErrSQLNotFound
+}
+
+func (r *fakeOAuthRepo) SaveSession(ctx context.Context, s *model.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions = append(r.sessions, s)
+	return nil
+}
+
+func newOAuthTestUsecase(t *testing.T) (*AuthUsecase, *fakeOAuthRepo, *oidctest.Provider) {
+	t.Helper()
+	mock := oidctest.New(t)
+	node, err := snowflake.NewNode(1)
+	if err != nil {
+		t.Fatalf("snowflake: %v", err)
+	}
+	repo := newFakeOAuthRepo()
+	uc := NewAuthUsecase(repo, &conf.Biz{
+		Auth: &conf.Biz_Auth{PwdSecrete: "pwd", JwtSecrete: "jwt"},
+		Oidc: []*conf.Biz_OIDC{{
+			Name:         "mock",
+			Issuer:       mock.Issuer,
+			ClientId:     oidctest.ClientID,
+			ClientSecret: oidctest.ClientSecret,
+			RedirectUrl:  "http://127.0.0.1/oauth/mock/callback",
+		}},
+	}, node, log.DefaultLogger)
+	return uc, repo, mock
+}
+
+// oauthLogin 发起登录并模拟用户授权，返回回调参数
+func oauthLogin(t *testing.T, uc *AuthUsecase, mock *oidctest.Provider, ctx context.Context, link bool, user oidctest.User) *model.OAuthCallbackParam {
+	t.Helper()
+	var (
+		authURL, binding string
+		err              error
+	)
+	if link {
+		authURL, binding, err = uc.OAuthLinkURL(ctx, "mock")
+	} else {
+		authURL, binding, err = uc.OAuthLoginURL(ctx, "mock", 0)
+	}
+	if err != nil {
+		t.Fatalf("auth url: %v", err)
+	}
+	code, state := mock.Authorize(t, authURL, user)
+	return &model.OAuthCallbackParam{
+		Provider: "mock",
+		State:    state,
+		Code:     code,
+		Binding:  binding,
+		Device:   &model.DeviceInfo{Name: "oauth"},
+	}
+}
+
+func uidFromToken(t *testing.T, res *model.Response) int64 {
+	t.Helper()
+	if res.Code != common.CodeSuccess || res.Data == nil {
+		t.Fatalf("response code = %d, want success", res.Code)
+	}
+	claims, err := jwt.Parse([]byte("jwt"), *res.Data)
+	if err != nil {
+		t.Fatalf("parse issued token: %v", err)
+	}
+	return claims.UID
+}
+
+func TestOAuthCallbackProvisionsUser(t *testing.T) {
+	uc, repo, mock := newOAuthTestUsecase(t)
+	ctx := context.Background()
+	user := oidctest.User{Subject: "sub-1", Email: "al.ice@example.com"}
+
+	res, err := uc.OAuthCallback(ctx, oauthLogin(t, uc, mock, ctx, false, user))
+	if err != nil {
+		t.Fatalf("OAuthCallback: %v", err)
+	}
+	uid := uidFromToken(t, res)
+	if len(repo.users) != 1 {
+		t.Fatalf("provisioned %d users, want 1", len(repo.users))
+	}
+	for name, info := range repo.users {
+		// 邮箱前缀过滤非法字符后不足6位，追加随机数字
+		if !strings.HasPrefix(name, "alice_") || len(name) != len("alice_")+5 {
+			t.Fatalf("user name = %q, want alice_ plus 5 digits", name)
+		}
+		if info.UID != uid {
+			t.Fatalf("provisioned uid = %d, token uid = %d", info.UID, uid)
+		}
+	}
+
+	// 再次登录使用已绑定的用户，不再创建新用户
+	res, err = uc.OAuthCallback(ctx, oauthLogin(t, uc, mock, ctx, false, user))
+	if err != nil {
+		t.Fatalf("second OAuthCallback: %v", err)
+	}
+	if got := uidFromToken(t, res); got != uid {
+		t.Fatalf("second login uid = %d, want %d", got, uid)
+	}
+	if len(repo.users) != 1 {
+		t.Fatalf("provisioned %d users after second login, want 1", len(repo.users))
+	}
+}
+
+func TestOAuthCallbackState(t *testing.T) {
+	uc, _, mock := newOAuthTestUsecase(t)
+	ctx := context.Background()
+	user := oidctest.User{Subject: "sub-1", PreferredUsername: "alice_forum"}
+
+	t.Run("unknown", func(t *testing.T) {
+		param := oauthLogin(t, uc, mock, ctx, false, user)
+		param.State = uc.signOAuthState("unknown", 0)
+		if _, err := uc.OAuthCallback(ctx, param); !errors.Is(err, common.ErrOAuthState) {
+			t.Fatalf("err = %v, want ErrOAuthState", err)
+		}
+	})
+	t.Run("bad signature", func(t *testing.T) {
+		param := oauthLogin(t, uc, mock, ctx, false, user)
+		param.State += "x"
+		if _, err := uc.OAuthCallback(ctx, param); !errors.Is(err, common.ErrOAuthState) {
+			t.Fatalf("err = %v, want ErrOAuthState", err)
+		}
+	})
+	t.Run("unknown provider", func(t *testing.T) {
+		param := oauthLogin(t, uc, mock, ctx, false, user)
+		param.Provider = "other"
+		if _, err := uc.OAuthCallback(ctx, param); !errors.Is(err, common.ErrOAuthProvider) {
+			t.Fatalf("err = %v, want ErrOAuthProvider", err)
+		}
+	})
+	t.Run("replay", func(t *testing.T) {
+		param := oauthLogin(t, uc, mock, ctx, false, user)
+		if _, err := uc.OAuthCallback(ctx, param); err != nil {
+			t.Fatalf("OAuthCallback: %v", err)
+		}
+		if _, err := uc.OAuthCallback(ctx, param); !errors.Is(err, common.ErrOAuthState) {
+			t.Fatalf("replayed state err = %v, want ErrOAuthState", err)
+		}
+	})
+}
+
+func TestOAuthCallbackNonceMismatch(t *testing.T) {
+	uc, repo, mock := newOAuthTestUsecase(t)
+	ctx := context.Background()
+	mock.Nonce = "replayed-nonce"
+
+	param := oauthLogin(t, uc, mock, ctx, false, oidctest.User{Subject: "sub-1"})
+	res, err := uc.OAuthCallback(ctx, param)
+	if err == nil || res.Code != common.CodeOAuthFailed {
+		t.Fatalf("OAuthCallback = %v, %v, want CodeOAuthFailed", res, err)
+	}
+	if len(repo.users) != 0 || len(repo.sessions) != 0 {
+		t.Fatal("user or session created despite nonce mismatch")
+	}
+}
+
+func TestOAuthLinkIdentity(t *testing.T) {
+	uc, repo, mock := newOAuthTestUsecase(t)
+	const uid int64 = 42
+	ctx := jwtkratos.NewContext(context.Background(), &jwt.Claims{UID: uid})
+	user := oidctest.User{Subject: "sub-1", Email: "alice@example.com"}
+
+	t.Run("tampered uid", func(t *testing.T) {
+		param := oauthLogin(t, uc, mock, ctx, true, user)
+		parts := strings.Split(param.State, ".")
+		parts[1] = "7"
+		param.State = strings.Join(parts, ".")
+		if _, err := uc.OAuthCallback(context.Background(), param); !errors.Is(err, common.ErrOAuthState) {
+			t.Fatalf("err = %v, want ErrOAuthState", err)
+		}
+	})
+
+	// 回调是浏览器跳转，不携带jwt，绑定的uid来自state
+	param := oauthLogin(t, uc, mock, ctx, true, user)
+	res, err := uc.OAuthCallback(context.Background(), param)
+	if err != nil {
+		t.Fatalf("OAuthCallback: %v", err)
+	}
+	if got := uidFromToken(t, res); got != uid {
+		t.Fatalf("linked login uid = %d, want %d", got, uid)
+	}
+	if len(repo.users) != 0 {
+		t.Fatal("linking provisioned a new user")
+	}
+	identity, err := repo.GetIdentity(context.Background(), "mock", "sub-1")
+	if err != nil || identity.UID != uid {
+		t.Fatalf("identity = %+v, %v, want uid %d", identity, err, uid)
+	}
+
+	// 已绑定到其他用户的第三方账号不能再次绑定
+	other := jwtkratos.NewContext(context.Background(), &jwt.Claims{UID: 43})
+	if _, err := uc.OAuthCallback(context.Background(), oauthLogin(t, uc, mock, other, true, user)); !errors.Is(err, common.ErrIdentityLinked) {
+		t.Fatalf("err = %v, want ErrIdentityLinked", err)
+	}
+}
+
+// 攻击者为自己的账号发起绑定，把授权地址发给受害者完成
+func TestOAuthCallbackFromOtherBrowser(t *testing.T) {
+	uc, repo, mock := newOAuthTestUsecase(t)
+	attacker := jwtkratos.NewContext(context.Background(), &jwt.Claims{UID: 42})
+	victim := oidctest.User{Subject: "victim", Email: "victim@example.com"}
+
+	for _, binding := range []string{"", "victim-browser-cookie"} {
+		param := oauthLogin(t, uc, mock, attacker, true, victim)
+		param.Binding = binding
+		if _, err := uc.OAuthCallback(context.Background(), param); !errors.Is(err, common.ErrOAuthBinding) {
+			t.Fatalf("binding %q: err = %v, want ErrOAuthBinding", binding, err)
+		}
+	}
+	if _, err := repo.GetIdentity(context.Background(), "mock", "victim"); !errors.Is(err, common.ErrSQLNotFound) {
+		t.Fatalf("victim identity linked: err = %v", err)
+	}
+	if len(repo.sessions) != 0 {
+		t.Fatal("session issued for a callback from another browser")
+	}
+}
+
+func TestOAuthLinkRequiresLogin(t *testing.T) {
+	uc, _, _ := newOAuthTestUsecase(t)
+	if _, _, err := uc.OAuthLinkURL(context.Background(), "mock"); !errors.Is(err, common.ErrTokenInvalid) {
+		t.Fatalf("err = %v, want ErrTokenInvalid", err)
+	}
+}
@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+)
+
+const sessionTTL = 72 * time.Hour // 与jwt有效期一致
+
+func (uc *AuthUsecase) newSession(ctx context.Context, uid int64, device *model.DeviceInfo) (*model.Session, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session := &model.Session{
+		SID:        hex.EncodeToString(buf),
+		UID:        uid,
+		CreateAt:   now,
+		LastSeenAt: now,
+		ExpireAt:   now.Add(sessionTTL),
+	}
+	if device != nil {
+		session.DeviceName = device.Name
+		session.UserAgent = device.UserAgent
+		session.IP = device.IP
+	}
+	if err := uc.repo.SaveSession(ctx, session); err != nil {
+		uc.log.Errorw(
+			"[biz]", "newSession/SaveSession failed",
+			"err", err,
+			"uid", uid,
+		)
+		return nil, err
+	}
+	return session, nil
+}
+
+// ListSessions 列出当前用户的有效会话，并返回发起请求的会话id
+func (uc *AuthUsecase) ListSessions(ctx context.Context) ([]*model.Session, string, error) {
+	claims, err := getClaimsFromCtx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	sessions, err := uc.repo.ListSessions(ctx, claims.UID)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "ListSessions/ListSessions failed",
+			"err", err,
+		)
+		return nil, "", err
+	}
+	return sessions, claims.SID, nil
+}
+
+// RevokeSession 吊销当前用户的某个会话，该会话签发的jwt随即失效
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, sid string) error {
+	uid, err := GetUIDFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := uc.repo.RevokeSession(ctx, uid, sid)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "RevokeSession/RevokeSession failed",
+			"err", err,
+			"sid", sid,
+		)
+		return err
+	}
+	if !ok {
+		return common.ErrSessionNotExist
+	}
+	return nil
+}
+
+// CheckSession 校验请求携带的jwt对应的会话是否仍然有效
+func (uc *AuthUsecase) CheckSession(ctx context.Context) error {
+	claims, err := getClaimsFromCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if claims.SID == "" {
+		// 会话功能上线前签发的token没有sid，在过期前继续放行
+		return nil
+	}
+	ok, err := uc.repo.TouchSession(ctx, claims.SID)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CheckSession/TouchSession failed",
+			"err", err,
+		)
+	}
+	if ok {
+		return nil
+	}
+
+	// redis中没有该会话时以数据库为准
+	session, err := uc.repo.GetSession(ctx, claims.SID)
+	if err != nil {
+		if errors.Is(err, common.ErrSQLNotFound) {
+			return common.ErrSessionRevoked
+		}
+		return err
+	}
+	if session.Revoked || session.UID != claims.UID || !session.ExpireAt.After(time.Now()) {
+		return common.ErrSessionRevoked
+	}
+	restored, err := uc.repo.RestoreSession(ctx, session)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "CheckSession/RestoreSession failed",
+			"err", err,
+		)
+		return nil
+	}
+	// 读取数据库之后会话被吊销
+	if !restored {
+		return common.ErrSessionRevoked
+	}
+	return nil
+}
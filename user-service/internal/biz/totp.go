@@ -0,0 +1,243 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+	"user-service/third_party/encrypt"
+	"user-service/third_party/jwt"
+	"user-service/third_party/totp"
+
+	jwtkratos "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+)
+
+const (
+	totpChallengeTTL   = 5 * time.Minute
+	totpMaxAttempts    = 5
+	totpSkew           = 1 // 允许前后各一个窗口的时钟偏差
+	recoveryCodeNum    = 10
+	recoveryCodeLength = 10
+)
+
+// EnableTOTP 为当前用户申请TOTP密钥，需要调用ConfirmTOTP确认后才会生效
+func (uc *AuthUsecase) EnableTOTP(ctx context.Context) (*model.TOTPEnrollment, error) {
+	uid, err := GetUIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := uc.repo.GetTOTPInfo(ctx, uid)
+	if err != nil && !errors.Is(err, common.ErrSQLNotFound) {
+		uc.log.Errorw(
+			"[biz]", "EnableTOTP/GetTOTPInfo failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	if info != nil && info.Enabled {
+		return nil, common.ErrTOTPEnabled
+	}
+
+	loginInfo, err := uc.repo.GetLogInfoByUID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, common.ErrSQLNotFound) {
+			return nil, common.ErrUserNotExist
+		}
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SaveTOTPSecret(ctx, uid, secret); err != nil {
+		uc.log.Errorw(
+			"[biz]", "EnableTOTP/SaveTOTPSecret failed",
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return &model.TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(uc.totpIssuer, loginInfo.UserName, secret),
+	}, nil
+}
+
+// ConfirmTOTP 校验验证器生成的第一个验证码，通过后开启二次验证并返回恢复码
+// 恢复码明文只在这里返回一次，数据库中只保存哈希
+func (uc *AuthUsecase) ConfirmTOTP(ctx context.Context, passcode string) ([]string, error) {
+	uid, err := GetUIDFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := uc.repo.GetTOTPInfo(ctx, uid)
+	if err != nil {
+		if errors.Is(err, common.ErrSQLNotFound) {
+			return nil, common.ErrTOTPNotEnrolled
+		}
+		return nil, err
+	}
+	if info.Enabled {
+		return nil, common.ErrTOTPEnabled
+	}
+	ok, err := uc.checkTOTP(ctx, info, passcode)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, common.ErrTOTPInvalid
+	}
+
+	codes := make([]string, 0, recoveryCodeNum)
+	hashes := make([]string, 0, recoveryCodeNum)
+	for range recoveryCodeNum {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, uc.hashRecoveryCode(code))
+	}
+	if err := uc.repo.EnableTOTP(ctx, uid, hashes); err != nil {
+		uc.log.Errorw(
+			"[biz]", "ConfirmTOTP/EnableTOTP failed",
+			"err", err,
+		)
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyTOTP 使用登录时得到的挑战与验证码（或恢复码）换取JWT
+func (uc *AuthUsecase) VerifyTOTP(ctx context.Context, param *model.VerifyTOTPParam) (*model.Response, error) {
+	uid, err := uc.repo.GetTOTPChallenge(ctx, param.Challenge)
+	if err != nil {
+		if errors.Is(err, common.ErrChallengeInvalid) {
+			return &model.Response{
+				Code: common.CodeTOTPInvalid,
+				Data: nil,
+			}, err
+		}
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+	info, err := uc.repo.GetTOTPInfo(ctx, uid)
+	if err != nil {
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+
+	ok, err := uc.checkTOTP(ctx, info, param.Passcode)
+	if err == nil && !ok {
+		ok, err = uc.repo.UseRecoveryCode(ctx, uid, uc.hashRecoveryCode(param.Passcode))
+	}
+	if err != nil {
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+	if !ok {
+		// 失败次数过多则作废挑战，需要重新登录
+		n, err := uc.repo.IncrTOTPAttempt(ctx, param.Challenge, totpChallengeTTL)
+		if err != nil {
+			uc.log.Errorw(
+				"[biz]", "VerifyTOTP/IncrTOTPAttempt failed",
+				"err", err,
+			)
+		}
+		if n >= totpMaxAttempts {
+			if err := uc.repo.DelTOTPChallenge(ctx, param.Challenge); err != nil {
+				uc.log.Errorw(
+					"[biz]", "VerifyTOTP/DelTOTPChallenge failed",
+					"err", err,
+				)
+			}
+		}
+		return &model.Response{
+			Code: common.CodeTOTPInvalid,
+			Data: nil,
+		}, common.ErrTOTPInvalid
+	}
+
+	// 挑战只能使用一次
+	if err := uc.repo.DelTOTPChallenge(ctx, param.Challenge); err != nil {
+		return &model.Response{
+			Code: common.CodeInternalErr,
+			Data: nil,
+		}, err
+	}
+	return uc.issueToken(ctx, uid, param.Device)
+}
+
+// checkTOTP 校验6位验证码，同一验证码只能使用一次
+func (uc *AuthUsecase) checkTOTP(ctx context.Context, info *model.TOTPInfo, passcode string) (bool, error) {
+	step, ok := totp.Validate(info.Secret, passcode, time.Now(), totpSkew)
+	if !ok {
+		return false, nil
+	}
+	ttl := time.Duration(2*totpSkew+1) * totp.Period
+	return uc.repo.MarkTOTPUsed(ctx, info.UID, step, ttl)
+}
+
+func (uc *AuthUsecase) newTOTPChallenge(ctx context.Context, uid int64) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	challenge := hex.EncodeToString(buf)
+	if err := uc.repo.SaveTOTPChallenge(ctx, challenge, uid, totpChallengeTTL); err != nil {
+		uc.log.Errorw(
+			"[biz]", "newTOTPChallenge/SaveTOTPChallenge failed",
+			"err", err,
+		)
+		return "", err
+	}
+	return challenge, nil
+}
+
+// hashRecoveryCode 忽略大小写与分隔符后计算哈希
+func (uc *AuthUsecase) hashRecoveryCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return encrypt.SHA256([]byte(code), uc.pwdSecrete)
+}
+
+// newRecoveryCode 生成形如ABCDE-FGHIJ的恢复码
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := base32.StdEncoding.EncodeToString(buf)[:recoveryCodeLength]
+	return code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:], nil
+}
+
+// GetUIDFromCtx 从经过jwt中间件的请求中获取uid
+func GetUIDFromCtx(ctx context.Context) (int64, error) {
+	claims, err := getClaimsFromCtx(ctx)
+	if err != nil {
+		return -1, err
+	}
+	return claims.UID, nil
+}
+
+func getClaimsFromCtx(ctx context.Context) (*jwt.Claims, error) {
+	claimsToken, ok := jwtkratos.FromContext(ctx)
+	if !ok {
+		return nil, common.ErrTokenInvalid
+	}
+	claims, ok := claimsToken.(*jwt.Claims)
+	if !ok {
+		return nil, common.ErrTokenInvalid
+	}
+	return claims, nil
+}
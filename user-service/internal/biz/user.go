@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+	"user-service/internal/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// levelPoints 各等级所需的最低积分，下标+1即为等级
+var levelPoints = []int64{0, 100, 500, 1500, 4000, 10000, 25000}
+
+// ngramTokenSize 与mysql的ngram_token_size保持一致
+const ngramTokenSize = 2
+
+type UserRepo interface {
+	BatchGetPublicProfiles(ctx context.Context, uids []int64) (map[int64]*model.PublicProfile, error)
+	SearchPublicProfiles(ctx context.Context, keyword string, fuzzy bool, offset, limit int64) ([]*model.PublicProfile, error)
+}
+
+type UserUsecase struct {
+	repo UserRepo
+	log  *log.Helper
+}
+
+func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
+	return &UserUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// BatchGetUsers 批量获取用户公开信息，结果按uids顺序返回，不存在的用户会被忽略
+func (uc *UserUsecase) BatchGetUsers(ctx context.Context, uids []int64) ([]*model.PublicProfile, error) {
+	// 去重后再查询，保留首次出现的顺序
+	seen := make(map[int64]struct{}, len(uids))
+	unique := make([]int64, 0, len(uids))
+	for _, uid := range uids {
+		if _, ok := seen[uid]; ok {
+			continue
+		}
+		seen[uid] = struct{}{}
+		unique = append(unique, uid)
+	}
+
+	profiles, err := uc.repo.BatchGetPublicProfiles(ctx, unique)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "BatchGetUsers/BatchGetPublicProfiles failed",
+			"err", err,
+		)
+		return nil, err
+	}
+
+	list := make([]*model.PublicProfile, 0, len(unique))
+	for _, uid := range unique {
+		p, ok := profiles[uid]
+		if !ok {
+			continue
+		}
+		p.Level = levelOf(p.Points)
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// SearchUsers 按用户名搜索，关键字不少于ngram分词长度时才进行模糊匹配
+func (uc *UserUsecase) SearchUsers(ctx context.Context, keyword string, page, pageSize int64) ([]*model.PublicProfile, bool, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return []*model.PublicProfile{}, false, nil
+	}
+	fuzzy := utf8.RuneCountInString(keyword) >= ngramTokenSize
+
+	// 多查一条用于判断是否还有下一页
+	list, err := uc.repo.SearchPublicProfiles(ctx, keyword, fuzzy, page*pageSize, pageSize+1)
+	if err != nil {
+		uc.log.Errorw(
+			"[biz]", "SearchUsers/SearchPublicProfiles failed",
+			"err", err,
+			"keyword", keyword,
+		)
+		return nil, false, err
+	}
+	hasMore := int64(len(list)) > pageSize
+	if hasMore {
+		list = list[:pageSize]
+	}
+	for _, p := range list {
+		p.Level = levelOf(p.Points)
+	}
+	return list, hasMore, nil
+}
+
+func levelOf(points int64) int32 {
+	level := 0
+	for i, p := range levelPoints {
+		if points < p {
+			break
+		}
+		level = i + 1
+	}
+	return int32(level)
+}
@@ -10,3 +10,10 @@ const (
 	CodeUserNotFond
 	CodePwdErr
 )
+
+// 登录流程相关状态码
+const (
+	CodeNeedTOTP    = 1001 // 密码校验通过，需要进行二次验证
+	CodeTOTPInvalid = 1002 // 二次验证失败
+	CodeOAuthFailed = 1003 // 第三方登录失败
+)
@@ -18,4 +18,22 @@ var (
 	ErrGetToken     = errors.New("获取token错误")
 
 	ErrPwdNotConsist = errors.New("两次密码不一致")
+
+	// 二次验证错误
+	ErrTOTPEnabled      = errors.New("已开启二次验证")
+	ErrTOTPNotEnrolled  = errors.New("未申请开启二次验证")
+	ErrTOTPInvalid      = errors.New("验证码错误")
+	ErrChallengeInvalid = errors.New("二次验证已过期，请重新登录")
+	ErrTokenInvalid     = errors.New("token无效")
+
+	// 会话错误
+	ErrSessionRevoked  = errors.New("登录已失效，请重新登录")
+	ErrSessionNotExist = errors.New("会话不存在")
+
+	// 第三方登录错误
+	ErrOAuthProvider   = errors.New("不支持的第三方登录")
+	ErrOAuthState      = errors.New("第三方登录已过期，请重新发起")
+	ErrOAuthBinding    = errors.New("请在发起第三方登录的浏览器中完成登录")
+	ErrIdentityLinked  = errors.New("该第三方账号已绑定其他用户")
+	ErrUserNameExhaust = errors.New("无法生成可用的用户名")
 )
@@ -0,0 +1,14 @@
+package common
+
+const (
+	RKeyTOTPChallenge = "user:totp_challenge:%s" // 登录二次验证的挑战，存储uid
+	RKeyTOTPAttempt   = "user:totp_attempt:%s"   // 挑战的验证失败次数
+	RKeyTOTPUsed      = "user:totp_used:%d:%d"   // 已使用的验证码，uid与时间窗口，防止重放
+
+	RKeySession        = "user:session:%s"         // 有效的会话，hash结构，存储uid与last_seen，其他服务据此校验jwt是否被吊销
+	RKeySessionRevoked = "user:session_revoked:%s" // 已吊销会话的墓碑，防止并发的恢复将其写回
+
+	RKeyOAuthState = "user:oauth_state:%s" // 第三方登录的state，存储PKCE verifier与nonce
+
+	RKeyPublicProfile = "user:public_profile:%d" // 用户公开信息缓存，json格式
+)
@@ -12,7 +12,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewDB, NewRDB, NewData, NewAuthRepo)
+var ProviderSet = wire.NewSet(NewDB, NewRDB, NewData, NewAuthRepo, NewUserRepo)
 
 // Data .
 type Data struct {
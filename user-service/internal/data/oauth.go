@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func (repo *authRepo) GetIdentity(ctx context.Context, provider, subject string) (*model.Identity, error) {
+	sqlStr := `
+	select uid, provider, subject, email from identity_info
+	where provider = ? and subject = ?`
+	identity := new(model.Identity)
+	if err := repo.data.db.GetContext(ctx, identity, sqlStr, provider, subject); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (repo *authRepo) SaveIdentity(ctx context.Context, identity *model.Identity) error {
+	sqlStr := `
+	insert into identity_info(uid, provider, subject, email)
+	value(?, ?, ?, ?)`
+	if _, err := repo.data.db.ExecContext(ctx, sqlStr,
+		identity.UID, identity.Provider, identity.Subject, identity.Email); err != nil {
+		repo.log.Debugw(
+			"[data]", "oauth.go",
+			"SaveIdentity error", err)
+		return err
+	}
+	return nil
+}
+
+// ProvisionOAuthUser 第三方身份首次登录时，在一个事务中创建login_info、user_info与绑定关系
+func (repo *authRepo) ProvisionOAuthUser(ctx context.Context, loginInfo *model.LoginInfo, identity *model.Identity) error {
+	tx, err := repo.data.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+	insert into login_info(uid, user_name, password)
+	value(?, ?, ?)`, loginInfo.UID, loginInfo.UserName, loginInfo.SecretPwd); err != nil {
+		repo.log.Debugw(
+			"[data]", "oauth.go",
+			"ProvisionOAuthUser login_info error", err)
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+	insert into user_info(uid, user_name)
+	value(?, ?)`, loginInfo.UID, loginInfo.UserName); err != nil {
+		repo.log.Debugw(
+			"[data]", "oauth.go",
+			"ProvisionOAuthUser user_info error", err)
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+	insert into identity_info(uid, provider, subject, email)
+	value(?, ?, ?, ?)`, identity.UID, identity.Provider, identity.Subject, identity.Email); err != nil {
+		repo.log.Debugw(
+			"[data]", "oauth.go",
+			"ProvisionOAuthUser identity_info error", err)
+		return err
+	}
+	return tx.Commit()
+}
+
+func (repo *authRepo) SaveOAuthState(ctx context.Context, state string, s *model.OAuthState, ttl time.Duration) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return repo.data.rdb.Set(ctx, fmt.Sprintf(common.RKeyOAuthState, state), b, ttl).Err()
+}
+
+// TakeOAuthState 取出并删除state，保证每个state只能回调一次
+func (repo *authRepo) TakeOAuthState(ctx context.Context, state string) (*model.OAuthState, error) {
+	b, err := repo.data.rdb.GetDel(ctx, fmt.Sprintf(common.RKeyOAuthState, state)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, common.ErrOAuthState
+		}
+		return nil, err
+	}
+	s := new(model.OAuthState)
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 会话存在时刷新last_seen，不存在时不创建
+var touchSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'last_seen', ARGV[1])
+	return 1
+end
+return 0`)
+
+// 会话未被吊销时写回redis，被吊销的会话留有墓碑，不能被并发的恢复写回
+var restoreSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'uid', ARGV[1], 'last_seen', ARGV[2])
+redis.call('EXPIREAT', KEYS[1], ARGV[3])
+return 1`)
+
+// 先写墓碑再删除会话，与restoreSessionScript互斥
+var revokeSessionScript = redis.NewScript(`
+redis.call('SET', KEYS[2], 1, 'EX', ARGV[1])
+redis.call('DEL', KEYS[1])
+return 1`)
+
+// 墓碑保留到所有会话都已过期，与jwt最长有效期一致
+const sessionRevokedTTL = 72 * time.Hour
+
+// 吊销时清理redis的重试次数
+const sessionRevokeRetry = 3
+
+func getSessionKey(sid string) string {
+	return fmt.Sprintf(common.RKeySession, sid)
+}
+
+func getSessionRevokedKey(sid string) string {
+	return fmt.Sprintf(common.RKeySessionRevoked, sid)
+}
+
+// SaveSession mysql持久化会话，redis保存有效会话供鉴权快速校验
+func (repo *authRepo) SaveSession(ctx context.Context, s *model.Session) error {
+	sqlStr := `
+	insert into session_info(sid, uid, device_name, user_agent, ip, expire_at)
+	value(?, ?, ?, ?, ?, ?)`
+	if _, err := repo.data.db.ExecContext(ctx, sqlStr,
+		s.SID, s.UID, s.DeviceName, s.UserAgent, s.IP, s.ExpireAt); err != nil {
+		repo.log.Debugw(
+			"[data]", "session.go",
+			"SaveSession error", err)
+		return err
+	}
+	return repo.cacheSession(ctx, s)
+}
+
+func (repo *authRepo) cacheSession(ctx context.Context, s *model.Session) error {
+	key := getSessionKey(s.SID)
+	pipe := repo.data.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "uid", s.UID, "last_seen", s.LastSeenAt.Unix())
+	pipe.ExpireAt(ctx, key, s.ExpireAt)
+	if _, err := pipe.Exec(ctx); err != nil {
+		repo.log.Debugw(
+			"[data]", "session.go",
+			"cacheSession error", err)
+		return err
+	}
+	return nil
+}
+
+func (repo *authRepo) GetSession(ctx context.Context, sid string) (*model.Session, error) {
+	sqlStr := `
+	select sid, uid, device_name, user_agent, ip, revoked, create_at, last_seen_at, expire_at
+	from session_info
+	where sid = ?`
+	s := new(model.Session)
+	if err := repo.data.db.GetContext(ctx, s, sqlStr, sid); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListSessions 列出用户未吊销且未过期的会话，last_seen以redis中的记录为准
+func (repo *authRepo) ListSessions(ctx context.Context, uid int64) ([]*model.Session, error) {
+	sqlStr := `
+	select sid, uid, device_name, user_agent, ip, revoked, create_at, last_seen_at, expire_at
+	from session_info
+	where uid = ? and revoked = 0 and expire_at > current_timestamp
+	order by create_at desc`
+	sessions := make([]*model.Session, 0)
+	if err := repo.data.db.SelectContext(ctx, &sessions, sqlStr, uid); err != nil {
+		repo.log.Debugw(
+			"[data]", "session.go",
+			"ListSessions error", err)
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return sessions, nil
+	}
+
+	pipe := repo.data.rdb.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(sessions))
+	for _, s := range sessions {
+		cmds = append(cmds, pipe.HGet(ctx, getSessionKey(s.SID), "last_seen"))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		// redis不可用时使用数据库中的记录
+		repo.log.Warnw(
+			"[data]", "session.go",
+			"ListSessions last_seen error", err)
+		return sessions, nil
+	}
+	for i, cmd := range cmds {
+		if ts, err := cmd.Int64(); err == nil {
+			sessions[i].LastSeenAt = time.Unix(ts, 0)
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSession 吊销会话，只能吊销属于uid的会话
+func (repo *authRepo) RevokeSession(ctx context.Context, uid int64, sid string) (bool, error) {
+	key := getSessionKey(sid)
+	lastSeen := time.Now()
+	if ts, err := repo.data.rdb.HGet(ctx, key, "last_seen").Int64(); err == nil {
+		lastSeen = time.Unix(ts, 0)
+	}
+
+	sqlStr := `
+	update session_info set revoked = 1, last_seen_at = ?
+	where uid = ? and sid = ? and revoked = 0`
+	res, err := repo.data.db.ExecContext(ctx, sqlStr, lastSeen, uid, sid)
+	if err != nil {
+		repo.log.Debugw(
+			"[data]", "session.go",
+			"RevokeSession error", err)
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	// 数据库中已吊销，清理缓存失败不影响吊销结果，重试后仍失败时记录日志
+	var delErr error
+	for i := range sessionRevokeRetry {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * 50 * time.Millisecond)
+		}
+		delErr = revokeSessionScript.Run(ctx, repo.data.rdb, []string{key, getSessionRevokedKey(sid)},
+			int64(sessionRevokedTTL.Seconds())).Err()
+		if delErr == nil {
+			break
+		}
+	}
+	if delErr != nil {
+		repo.log.Errorw(
+			"[data]", "session.go",
+			"RevokeSession cache error", delErr,
+			"sid", sid)
+	}
+	return true, nil
+}
+
+// TouchSession 刷新会话的last_seen，返回false表示redis中没有该会话
+func (repo *authRepo) TouchSession(ctx context.Context, sid string) (bool, error) {
+	n, err := touchSessionScript.Run(ctx, repo.data.rdb, []string{getSessionKey(sid)}, time.Now().Unix()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// RestoreSession 将数据库中仍有效的会话重新写入redis
+// 读取数据库之后会话被吊销时返回false，不会写回redis
+func (repo *authRepo) RestoreSession(ctx context.Context, s *model.Session) (bool, error) {
+	s.LastSeenAt = time.Now()
+	n, err := restoreSessionScript.Run(ctx, repo.data.rdb,
+		[]string{getSessionKey(s.SID), getSessionRevokedKey(s.SID)},
+		s.UID, s.LastSeenAt.Unix(), s.ExpireAt.Unix()).Int()
+	if err != nil {
+		repo.log.Debugw(
+			"[data]", "session.go",
+			"RestoreSession error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
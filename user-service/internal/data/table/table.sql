@@ -6,7 +6,9 @@ CREATE TABLE `login_info` (
     `update_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     `user_name` varchar(16) NOT NULL,
     `password` char(64) NOT NULL,
+    `is_banned` TINYINT NOT NULL DEFAULT 0,
     INDEX `idx_user_name` (`user_name`),
+    FULLTEXT INDEX `ft_user_name` (`user_name`) WITH PARSER ngram, -- 模糊搜索，ngram_token_size取默认值2
     UNIQUE INDEX `idx_uid_type` (`uid`, `is_del`)
 )
 CREATE TABLE `user_info` (
@@ -20,7 +22,52 @@ CREATE TABLE `user_info` (
     `phone` varchar(20) DEFAULT NULL,
     `gender` TINYINT DEFAULT 3, -- 3表示未知
     `points` BIGINT DEFAULT 0,
+    `avatar` varchar(256) NOT NULL DEFAULT '',
     `ext_json` varchar(256),
     UNIQUE INDEX `idx_uid_del` (`uid`, `is_del`),
     PRIMARY KEY (`id`)
-)
\ No newline at end of file
+)
+CREATE TABLE `totp_info` (
+    `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `uid` BIGINT NOT NULL,
+    `create_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `update_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `secret` varchar(64) NOT NULL,
+    `enabled` TINYINT NOT NULL DEFAULT 0, -- 0申请中，1已开启
+    UNIQUE INDEX `idx_uid` (`uid`)
+)
+CREATE TABLE `totp_recovery_code` (
+    `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `uid` BIGINT NOT NULL,
+    `create_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `code_hash` char(64) NOT NULL, -- 恢复码只保存哈希
+    `used_at` TIMESTAMP NULL DEFAULT NULL,
+    INDEX `idx_uid_code` (`uid`, `code_hash`)
+)
+
+CREATE TABLE `session_info` (
+    `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `sid` char(32) NOT NULL,
+    `uid` BIGINT NOT NULL,
+    `device_name` varchar(64) NOT NULL DEFAULT '',
+    `user_agent` varchar(256) NOT NULL DEFAULT '',
+    `ip` varchar(64) NOT NULL DEFAULT '',
+    `revoked` TINYINT NOT NULL DEFAULT 0,
+    `create_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `last_seen_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `expire_at` TIMESTAMP NOT NULL,
+    UNIQUE INDEX `idx_sid` (`sid`),
+    INDEX `idx_uid` (`uid`)
+)
+
+CREATE TABLE `identity_info` (
+    `id` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `uid` BIGINT NOT NULL,
+    `create_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `update_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `provider` varchar(32) NOT NULL, -- 对应配置中的oidc.name
+    `subject` varchar(255) NOT NULL, -- id_token中的sub
+    `email` varchar(255) NOT NULL DEFAULT '',
+    UNIQUE INDEX `idx_provider_subject` (`provider`, `subject`),
+    INDEX `idx_uid` (`uid`)
+)
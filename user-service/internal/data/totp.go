@@ -0,0 +1,146 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/common"
+	"user-service/internal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func (repo *authRepo) GetLogInfoByUID(ctx context.Context, uid int64) (*model.LoginInfo, error) {
+	sqlStr := `
+	select uid, user_name, password from login_info
+	where uid = ? and is_del = 0`
+	info := new(model.LoginInfo)
+	if err := repo.data.db.GetContext(ctx, info, sqlStr, uid); err != nil {
+		repo.log.Debugw(
+			"[data]", "totp.go",
+			"GetLogInfoByUID error", err)
+		return nil, err
+	}
+	return info, nil
+}
+
+func (repo *authRepo) GetTOTPInfo(ctx context.Context, uid int64) (*model.TOTPInfo, error) {
+	sqlStr := `
+	select uid, secret, enabled from totp_info
+	where uid = ?`
+	info := new(model.TOTPInfo)
+	if err := repo.data.db.GetContext(ctx, info, sqlStr, uid); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// SaveTOTPSecret 保存新申请的密钥，重复申请会覆盖之前未确认的密钥
+func (repo *authRepo) SaveTOTPSecret(ctx context.Context, uid int64, secret string) error {
+	sqlStr := `
+	insert into totp_info(uid, secret, enabled)
+	value(?, ?, 0)
+	on duplicate key update secret = values(secret), enabled = 0`
+	if _, err := repo.data.db.ExecContext(ctx, sqlStr, uid, secret); err != nil {
+		repo.log.Debugw(
+			"[data]", "totp.go",
+			"SaveTOTPSecret error", err)
+		return err
+	}
+	return nil
+}
+
+// EnableTOTP 开启二次验证，同时替换用户的全部恢复码
+func (repo *authRepo) EnableTOTP(ctx context.Context, uid int64, codeHashes []string) error {
+	tx, err := repo.data.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `update totp_info set enabled = 1 where uid = ?`, uid); err != nil {
+		repo.log.Debugw(
+			"[data]", "totp.go",
+			"EnableTOTP update error", err)
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `delete from totp_recovery_code where uid = ?`, uid); err != nil {
+		repo.log.Debugw(
+			"[data]", "totp.go",
+			"EnableTOTP delete error", err)
+		return err
+	}
+	for _, h := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `insert into totp_recovery_code(uid, code_hash) value(?, ?)`, uid, h); err != nil {
+			repo.log.Debugw(
+				"[data]", "totp.go",
+				"EnableTOTP insert error", err)
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// UseRecoveryCode 核销恢复码，每个恢复码只能使用一次
+func (repo *authRepo) UseRecoveryCode(ctx context.Context, uid int64, codeHash string) (bool, error) {
+	sqlStr := `
+	update totp_recovery_code set used_at = current_timestamp
+	where uid = ? and code_hash = ? and used_at is null
+	limit 1`
+	res, err := repo.data.db.ExecContext(ctx, sqlStr, uid, codeHash)
+	if err != nil {
+		repo.log.Debugw(
+			"[data]", "totp.go",
+			"UseRecoveryCode error", err)
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (repo *authRepo) SaveTOTPChallenge(ctx context.Context, challenge string, uid int64, ttl time.Duration) error {
+	key := fmt.Sprintf(common.RKeyTOTPChallenge, challenge)
+	return repo.data.rdb.Set(ctx, key, uid, ttl).Err()
+}
+
+// GetTOTPChallenge 获取挑战对应的uid，挑战不存在或已过期返回ErrChallengeInvalid
+func (repo *authRepo) GetTOTPChallenge(ctx context.Context, challenge string) (int64, error) {
+	key := fmt.Sprintf(common.RKeyTOTPChallenge, challenge)
+	uid, err := repo.data.rdb.Get(ctx, key).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, common.ErrChallengeInvalid
+		}
+		return 0, err
+	}
+	return uid, nil
+}
+
+func (repo *authRepo) DelTOTPChallenge(ctx context.Context, challenge string) error {
+	return repo.data.rdb.Del(ctx,
+		fmt.Sprintf(common.RKeyTOTPChallenge, challenge),
+		fmt.Sprintf(common.RKeyTOTPAttempt, challenge),
+	).Err()
+}
+
+// IncrTOTPAttempt 记录一次验证失败，返回当前失败次数
+func (repo *authRepo) IncrTOTPAttempt(ctx context.Context, challenge string, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf(common.RKeyTOTPAttempt, challenge)
+	pipe := repo.data.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// MarkTOTPUsed 标记验证码已使用，返回false表示该验证码已被使用过
+func (repo *authRepo) MarkTOTPUsed(ctx context.Context, uid, step int64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf(common.RKeyTOTPUsed, uid, step)
+	return repo.data.rdb.SetNX(ctx, key, 1, ttl).Result()
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+	"user-service/internal/biz"
+	"user-service/internal/common"
+	"user-service/internal/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	profileCacheTTL     = 10 * time.Minute
+	profileCacheJitter  = 5 * time.Minute // 过期时间抖动，避免缓存同时失效
+	profileNotFoundTTL  = time.Minute
+	profileNotFoundMark = "null" // 缓存不存在的用户，防止缓存穿透
+)
+
+// likeEscaper 转义like中的通配符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type userRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+	return &userRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func getPublicProfileKey(uid int64) string {
+	return fmt.Sprintf(common.RKeyPublicProfile, uid)
+}
+
+// BatchGetPublicProfiles 先通过MGET读取缓存，未命中的用户一次性从数据库查询并回填缓存
+// 返回的map中不包含不存在的用户
+func (repo *userRepo) BatchGetPublicProfiles(ctx context.Context, uids []int64) (map[int64]*model.PublicProfile, error) {
+	profiles := make(map[int64]*model.PublicProfile, len(uids))
+	keys := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		keys = append(keys, getPublicProfileKey(uid))
+	}
+
+	misses := make([]int64, 0)
+	vals, err := repo.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		// redis出错时全部回源数据库
+		repo.log.Debugw(
+			"[data]", "user.go",
+			"BatchGetPublicProfiles MGet error", err)
+		misses = append(misses, uids...)
+	} else {
+		for i, val := range vals {
+			str, ok := val.(string)
+			if !ok {
+				misses = append(misses, uids[i])
+				continue
+			}
+			if str == profileNotFoundMark {
+				continue
+			}
+			profile := new(model.PublicProfile)
+			if err := json.Unmarshal([]byte(str), profile); err != nil {
+				misses = append(misses, uids[i])
+				continue
+			}
+			profiles[profile.UID] = profile
+		}
+	}
+	if len(misses) == 0 {
+		return profiles, nil
+	}
+
+	found, err := repo.getPublicProfilesFromDB(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	pipe := repo.data.rdb.Pipeline()
+	for _, uid := range misses {
+		profile, ok := found[uid]
+		if !ok {
+			pipe.Set(ctx, getPublicProfileKey(uid), profileNotFoundMark, profileNotFoundTTL)
+			continue
+		}
+		profiles[uid] = profile
+		b, err := json.Marshal(profile)
+		if err != nil {
+			continue
+		}
+		ttl := profileCacheTTL + time.Duration(rand.Int64N(int64(profileCacheJitter)))
+		pipe.Set(ctx, getPublicProfileKey(uid), b, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		repo.log.Debugw(
+			"[data]", "user.go",
+			"BatchGetPublicProfiles cache error", err)
+	}
+	return profiles, nil
+}
+
+func (repo *userRepo) getPublicProfilesFromDB(ctx context.Context, uids []int64) (map[int64]*model.PublicProfile, error) {
+	// 注册时只写入login_info，user_info可能不存在
+	sqlStr, args, err := sqlx.In(`
+	select l.uid, l.user_name, coalesce(u.avatar, '') as avatar, coalesce(u.points, 0) as points
+	from login_info l
+	left join user_info u on u.uid = l.uid and u.is_del = 0
+	where l.uid in (?) and l.is_del = 0`, uids)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*model.PublicProfile, 0, len(uids))
+	if err := repo.data.db.SelectContext(ctx, &list, repo.data.db.Rebind(sqlStr), args...); err != nil {
+		repo.log.Debugw(
+			"[data]", "user.go",
+			"getPublicProfilesFromDB error", err)
+		return nil, err
+	}
+	profiles := make(map[int64]*model.PublicProfile, len(list))
+	for _, p := range list {
+		profiles[p.UID] = p
+	}
+	return profiles, nil
+}
+
+// SearchPublicProfiles 前缀匹配走idx_user_name，模糊匹配走ngram全文索引
+// 前缀匹配的结果排在前面，其余按全文相关度排序
+func (repo *userRepo) SearchPublicProfiles(ctx context.Context, keyword string, fuzzy bool, offset, limit int64) ([]*model.PublicProfile, error) {
+	prefix := likeEscaper.Replace(keyword) + "%"
+	cond := "l.user_name like ?"
+	args := []any{prefix}
+	if fuzzy {
+		cond = "(l.user_name like ? or match(l.user_name) against(? in natural language mode))"
+		args = append(args, keyword)
+	}
+	args = append(args, prefix, keyword, limit, offset)
+
+	sqlStr := `
+	select l.uid, l.user_name, coalesce(u.avatar, '') as avatar, coalesce(u.points, 0) as points
+	from login_info l
+	left join user_info u on u.uid = l.uid and u.is_del = 0
+	where l.is_del = 0 and l.is_banned = 0 and ` + cond + `
+	order by l.user_name like ? desc, match(l.user_name) against(? in natural language mode) desc, l.uid
+	limit ? offset ?`
+	list := make([]*model.PublicProfile, 0, limit)
+	if err := repo.data.db.SelectContext(ctx, &list, sqlStr, args...); err != nil {
+		repo.log.Debugw(
+			"[data]", "user.go",
+			"SearchPublicProfiles error", err)
+		return nil, err
+	}
+	return list, nil
+}
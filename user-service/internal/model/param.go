@@ -3,6 +3,14 @@ package model
 type LoginParam struct {
 	UserName string
 	Pwd      string
+	Device   *DeviceInfo
+}
+
+// DeviceInfo 发起登录的设备信息，用于记录会话
+type DeviceInfo struct {
+	Name      string
+	UserAgent string
+	IP        string
 }
 
 type SignupParam struct {
@@ -11,3 +19,26 @@ type SignupParam struct {
 	RePwd    string
 	Tel      string
 }
+
+type VerifyTOTPParam struct {
+	Challenge string
+	Passcode  string
+	Device    *DeviceInfo
+}
+
+// OAuthState 第三方登录发起时保存的状态，回调时取回
+type OAuthState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	// BindingHash 发起登录的浏览器cookie中绑定值的哈希
+	BindingHash string `json:"binding_hash"`
+}
+
+type OAuthCallbackParam struct {
+	Provider string
+	State    string
+	Code     string
+	Binding  string // 浏览器cookie中的绑定值
+	Device   *DeviceInfo
+}
@@ -1,12 +1,54 @@
 package model
 
+import "time"
+
 type LoginInfo struct {
 	UID       int64  `db:"uid"`
 	UserName  string `db:"user_name"`
 	SecretPwd string `db:"password"`
 }
 
+type Session struct {
+	SID        string    `db:"sid"`
+	UID        int64     `db:"uid"`
+	DeviceName string    `db:"device_name"`
+	UserAgent  string    `db:"user_agent"`
+	IP         string    `db:"ip"`
+	Revoked    bool      `db:"revoked"`
+	CreateAt   time.Time `db:"create_at"`
+	LastSeenAt time.Time `db:"last_seen_at"`
+	ExpireAt   time.Time `db:"expire_at"`
+}
+
+// PublicProfile 用户的公开信息，可被其他用户看到
+type PublicProfile struct {
+	UID      int64  `json:"uid" db:"uid"`
+	UserName string `json:"user_name" db:"user_name"`
+	Avatar   string `json:"avatar" db:"avatar"`
+	Points   int64  `json:"points" db:"points"`
+	Level    int32  `json:"level" db:"-"`
+}
+
+// Identity 第三方身份与本地用户的绑定关系
+type Identity struct {
+	UID      int64  `db:"uid"`
+	Provider string `db:"provider"`
+	Subject  string `db:"subject"`
+	Email    string `db:"email"`
+}
+
 type Response struct {
 	Code int32
 	Data *string
 }
+
+type TOTPInfo struct {
+	UID     int64  `db:"uid"`
+	Secret  string `db:"secret"`
+	Enabled bool   `db:"enabled"`
+}
+
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
@@ -2,6 +2,8 @@ package server
 
 import (
 	v1 "user-service/api/auth/v1"
+	v1user "user-service/api/user/v1"
+	"user-service/internal/biz"
 	"user-service/internal/conf"
 	"user-service/internal/service"
 
@@ -12,11 +14,12 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, auther *service.AuthService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, cb *conf.Biz, auther *service.AuthService, user *service.UserService, authUc *biz.AuthUsecase, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			validate.Validator(),
+			newAuthMiddleware(cb, authUc),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -30,5 +33,6 @@ func NewGRPCServer(c *conf.Server, auther *service.AuthService, logger log.Logge
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterAuthServer(srv, auther)
+	v1user.RegisterUserServer(srv, user)
 	return srv
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
 	"user-service/internal/conf"
 	"user-service/internal/service"
 
@@ -12,11 +13,12 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, auther *service.AuthService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, cb *conf.Biz, auther *service.AuthService, oauth *service.OAuthService, authUc *biz.AuthUsecase, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			validate.Validator(),
+			newAuthMiddleware(cb, authUc),
 		),
 	}
 	if c.Http.Network != "" {
@@ -30,5 +32,11 @@ func NewHTTPServer(c *conf.Server, auther *service.AuthService, logger log.Logge
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAuthHTTPServer(srv, auther)
+
+	// 第三方登录
+	r := srv.Route("/")
+	r.GET("/oauth/{provider}/login", oauth.Login)
+	r.POST("/oauth/{provider}/link", oauth.Link)
+	r.GET("/oauth/{provider}/callback", oauth.Callback)
 	return srv
 }
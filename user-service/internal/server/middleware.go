@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+	tokenjwt "user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	kratosjwt "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 需要登录后才能访问的接口
+var authOperations = map[string]struct{}{
+	"/auth.v1.Auth/EnableTOTP":    {},
+	"/auth.v1.Auth/ConfirmTOTP":   {},
+	"/auth.v1.Auth/ListSessions":  {},
+	"/auth.v1.Auth/RevokeSession": {},
+	"/auth.v1.OAuth/Link":         {},
+}
+
+// newAuthMiddleware 只对authOperations中的接口校验jwt与会话
+func newAuthMiddleware(c *conf.Biz, uc *biz.AuthUsecase) middleware.Middleware {
+	return selector.Server(
+		kratosjwt.Server(func(token *jwt.Token) (interface{}, error) {
+			return []byte(c.Auth.JwtSecrete), nil
+		}, kratosjwt.WithClaims(func() jwt.Claims {
+			return &tokenjwt.Claims{}
+		})),
+		sessionMiddleware(uc),
+	).Match(func(ctx context.Context, operation string) bool {
+		_, ok := authOperations[operation]
+		return ok
+	}).Build()
+}
+
+// sessionMiddleware 拒绝已被吊销的会话
+func sessionMiddleware(uc *biz.AuthUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := uc.CheckSession(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
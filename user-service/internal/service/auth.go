@@ -5,7 +5,10 @@ import (
 
 	pb "user-service/api/auth/v1"
 	"user-service/internal/biz"
+	"user-service/internal/common"
 	"user-service/internal/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthService struct {
@@ -23,6 +26,7 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 	param := &model.LoginParam{
 		UserName: req.UserName,
 		Pwd:      req.Password,
+		Device:   deviceFromCtx(ctx, req.DeviceName),
 	}
 
 	res, err := s.uc.Login(ctx, param)
@@ -54,3 +58,77 @@ func (s *AuthService) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.Si
 		Code: res.Code,
 	}, nil
 }
+
+func (s *AuthService) EnableTOTP(ctx context.Context, req *pb.EnableTOTPRequest) (*pb.EnableTOTPResponse, error) {
+	enrollment, err := s.uc.EnableTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EnableTOTPResponse{
+		Code:   common.CodeSuccess,
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	}, nil
+}
+
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	codes, err := s.uc.ConfirmTOTP(ctx, req.Passcode)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmTOTPResponse{
+		Code:          common.CodeSuccess,
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s *AuthService) VerifyTOTP(ctx context.Context, req *pb.VerifyTOTPRequest) (*pb.LoginResponse, error) {
+	param := &model.VerifyTOTPParam{
+		Challenge: req.Challenge,
+		Passcode:  req.Passcode,
+		Device:    deviceFromCtx(ctx, req.DeviceName),
+	}
+	res, err := s.uc.VerifyTOTP(ctx, param)
+	if err != nil {
+		return &pb.LoginResponse{
+			Code: res.Code,
+		}, err
+	}
+	return &pb.LoginResponse{
+		Code: res.Code,
+		Data: *res.Data,
+	}, nil
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, current, err := s.uc.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	respSessions := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		respSessions = append(respSessions, &pb.Session{
+			Sid:        session.SID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreateAt:   timestamppb.New(session.CreateAt),
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			ExpireAt:   timestamppb.New(session.ExpireAt),
+			Current:    session.SID == current,
+		})
+	}
+	return &pb.ListSessionsResponse{
+		Code:     common.CodeSuccess,
+		Sessions: respSessions,
+	}, nil
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	if err := s.uc.RevokeSession(ctx, req.Sid); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeSessionResponse{
+		Code: common.CodeSuccess,
+	}, nil
+}
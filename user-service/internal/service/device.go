@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+	"user-service/internal/model"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+const maxUserAgentLen = 256
+
+// deviceFromCtx 从请求头与连接信息中提取设备信息
+func deviceFromCtx(ctx context.Context, name string) *model.DeviceInfo {
+	device := &model.DeviceInfo{Name: name}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		header := tr.RequestHeader()
+		device.UserAgent = header.Get("User-Agent")
+		// 经过网关转发时以网关传递的地址为准
+		if ip := header.Get("X-Real-IP"); ip != "" {
+			device.IP = ip
+		} else if xff := header.Get("X-Forwarded-For"); xff != "" {
+			device.IP = strings.TrimSpace(strings.Split(xff, ",")[0])
+		}
+	}
+	if device.IP == "" {
+		var addr string
+		if req, ok := http.RequestFromServerContext(ctx); ok {
+			addr = req.RemoteAddr
+		} else if p, ok := peer.FromContext(ctx); ok {
+			addr = p.Addr.String()
+		}
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			device.IP = host
+		} else {
+			device.IP = addr
+		}
+	}
+	if len(device.UserAgent) > maxUserAgentLen {
+		device.UserAgent = device.UserAgent[:maxUserAgentLen]
+	}
+	return device
+}
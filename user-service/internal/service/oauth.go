@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	nethttp "net/http"
+
+	pb "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/common"
+	"user-service/internal/model"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	OperationOAuthLogin    = "/auth.v1.OAuth/Login"
+	OperationOAuthLink     = "/auth.v1.OAuth/Link"
+	OperationOAuthCallback = "/auth.v1.OAuth/Callback"
+
+	// oauthBindingCookie 将第三方登录绑定到发起的浏览器，防止授权地址被转发给他人完成
+	oauthBindingCookie = "oauth_binding"
+)
+
+// OAuthService 第三方登录（OIDC授权码+PKCE）的http接口
+// 授权流程依赖浏览器跳转，因此不在proto中定义
+type OAuthService struct {
+	uc *biz.AuthUsecase
+}
+
+func NewOAuthService(uc *biz.AuthUsecase) *OAuthService {
+	return &OAuthService{uc: uc}
+}
+
+// Login GET /oauth/{provider}/login
+func (s *OAuthService) Login(ctx http.Context) error {
+	provider := ctx.Vars().Get("provider")
+
+	http.SetOperation(ctx, OperationOAuthLogin)
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		authURL, binding, err := s.uc.OAuthLoginURL(c, provider, 0)
+		if err != nil {
+			return nil, err
+		}
+		setBindingCookie(ctx, binding, int(biz.OAuthStateTTL.Seconds()))
+		return authURL, nil
+	})
+	out, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	nethttp.Redirect(ctx.Response(), ctx.Request(), out.(string), nethttp.StatusFound)
+	return nil
+}
+
+// Link POST /oauth/{provider}/link
+// 浏览器跳转不会携带Authorization头，因此由前端携带jwt调用该接口获取授权地址后再跳转
+// 返回的Data为授权地址，当前用户的uid随签名后的state传递到回调
+func (s *OAuthService) Link(ctx http.Context) error {
+	provider := ctx.Vars().Get("provider")
+
+	http.SetOperation(ctx, OperationOAuthLink)
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		authURL, binding, err := s.uc.OAuthLinkURL(c, provider)
+		if err != nil {
+			return nil, err
+		}
+		setBindingCookie(ctx, binding, int(biz.OAuthStateTTL.Seconds()))
+		return &pb.LoginResponse{
+			Code: common.CodeSuccess,
+			Data: authURL,
+		}, nil
+	})
+	out, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(nethttp.StatusOK, out)
+}
+
+// Callback GET /oauth/{provider}/callback
+// 返回结果与Login接口一致，开启二次验证的用户会得到挑战
+func (s *OAuthService) Callback(ctx http.Context) error {
+	query := ctx.Query()
+	if errMsg := query.Get("error"); errMsg != "" {
+		return ctx.Result(nethttp.StatusBadRequest, &pb.LoginResponse{
+			Code: common.CodeOAuthFailed,
+			Data: errMsg,
+		})
+	}
+
+	var binding string
+	if cookie, err := ctx.Request().Cookie(oauthBindingCookie); err == nil {
+		binding = cookie.Value
+	}
+	// state只能使用一次，绑定值随之失效
+	setBindingCookie(ctx, "", -1)
+
+	http.SetOperation(ctx, OperationOAuthCallback)
+	h := ctx.Middleware(func(c context.Context, req interface{}) (interface{}, error) {
+		param := &model.OAuthCallbackParam{
+			Provider: ctx.Vars().Get("provider"),
+			State:    query.Get("state"),
+			Code:     query.Get("code"),
+			Binding:  binding,
+			Device:   deviceFromCtx(c, "oauth"),
+		}
+		res, err := s.uc.OAuthCallback(c, param)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.LoginResponse{
+			Code: res.Code,
+			Data: *res.Data,
+		}, nil
+	})
+	out, err := h(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return ctx.Result(nethttp.StatusOK, out)
+}
+
+// setBindingCookie 回调是身份提供方发起的跨站跳转，SameSite只能使用Lax
+func setBindingCookie(ctx http.Context, value string, maxAge int) {
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{
+		Name:     oauthBindingCookie,
+		Value:    value,
+		Path:     "/oauth/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   ctx.Request().TLS != nil || ctx.Header().Get("X-Forwarded-Proto") == "https",
+		SameSite: nethttp.SameSiteLaxMode,
+	})
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 
 	pb "user-service/api/user/v1"
+	"user-service/internal/biz"
+	"user-service/internal/model"
 )
 
 type UserService struct {
 	pb.UnimplementedUserServer
+
+	uc *biz.UserUsecase
 }
 
-func NewUserService() *UserService {
-	return &UserService{}
+func NewUserService(uc *biz.UserUsecase) *UserService {
+	return &UserService{uc: uc}
 }
 
 func (s *UserService) GetUserByUID(ctx context.Context, req *pb.GetUserByUIDRequest) (*pb.GetUserByUIDReply, error) {
@@ -20,3 +24,35 @@ func (s *UserService) GetUserByUID(ctx context.Context, req *pb.GetUserByUIDRequ
 func (s *UserService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileReply, error) {
 	return &pb.GetProfileReply{}, nil
 }
+
+func (s *UserService) BatchGetUsers(ctx context.Context, req *pb.BatchGetUsersRequest) (*pb.BatchGetUsersReply, error) {
+	profiles, err := s.uc.BatchGetUsers(ctx, req.Uids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchGetUsersReply{Users: toPublicProfiles(profiles)}, nil
+}
+
+func (s *UserService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersReply, error) {
+	profiles, hasMore, err := s.uc.SearchUsers(ctx, req.Keyword, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SearchUsersReply{
+		Users:   toPublicProfiles(profiles),
+		HasMore: hasMore,
+	}, nil
+}
+
+func toPublicProfiles(profiles []*model.PublicProfile) []*pb.PublicProfile {
+	users := make([]*pb.PublicProfile, 0, len(profiles))
+	for _, p := range profiles {
+		users = append(users, &pb.PublicProfile{
+			Uid:      p.UID,
+			Username: p.UserName,
+			Avatar:   p.Avatar,
+			Level:    p.Level,
+		})
+	}
+	return users
+}
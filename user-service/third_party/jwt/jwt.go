@@ -9,7 +9,8 @@ import (
 type option func(*Claims)
 
 type Claims struct {
-	UID int64 `json:"uid"`
+	UID int64  `json:"uid"`
+	SID string `json:"sid,omitempty"` // 会话id，用于吊销已签发的token
 	jwt.RegisteredClaims
 }
 
@@ -48,3 +49,20 @@ func WithUID(uid int64) option {
 		c.UID = uid
 	}
 }
+func WithSID(sid string) option {
+	return func(c *Claims) {
+		c.SID = sid
+	}
+}
+
+// Parse 校验token签名与有效期并返回其中的声明
+func Parse(secrete []byte, token string) (*Claims, error) {
+	claims := new(Claims)
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return secrete, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
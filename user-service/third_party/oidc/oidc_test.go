@@ -0,0 +1,227 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"user-service/third_party/oidc/oidctest"
+)
+
+var testUser = oidctest.User{
+	Subject:           "sub-1",
+	Email:             "alice@example.com",
+	PreferredUsername: "alice",
+}
+
+func newTestProvider(t *testing.T) (*Provider, *oidctest.Provider) {
+	t.Helper()
+	mock := oidctest.New(t)
+	p := New(Config{
+		Name:         "mock",
+		Issuer:       mock.Issuer,
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://127.0.0.1/oauth/mock/callback",
+	})
+	return p, mock
+}
+
+// login 走完授权码流程，返回id_token
+func login(t *testing.T, p *Provider, mock *oidctest.Provider, nonce string) string {
+	t.Helper()
+	ctx := context.Background()
+	verifier, err := RandomString()
+	if err != nil {
+		t.Fatalf("RandomString: %v", err)
+	}
+	authURL, err := p.AuthCodeURL(ctx, "state-1", nonce, verifier)
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	code, state := mock.Authorize(t, authURL, testUser)
+	if state != "state-1" {
+		t.Fatalf("state = %q, want state-1", state)
+	}
+	token, err := p.Exchange(ctx, code, verifier)
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	return token.IDToken
+}
+
+func TestLoginFlow(t *testing.T) {
+	p, mock := newTestProvider(t)
+	idToken := login(t, p, mock, "nonce-1")
+
+	claims, err := p.VerifyIDToken(context.Background(), idToken, "nonce-1")
+	if err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	if claims.Subject != testUser.Subject || claims.Email != testUser.Email ||
+		claims.PreferredUsername != testUser.PreferredUsername {
+		t.Fatalf("claims = %+v, want %+v", claims, testUser)
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	p, mock := newTestProvider(t)
+	authURL, err := p.AuthCodeURL(context.Background(), "s", "n", "verifier")
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	if !strings.HasPrefix(authURL, mock.Issuer+"/authorize?") {
+		t.Fatalf("auth url %q does not use the discovered endpoint", authURL)
+	}
+	if !strings.Contains(authURL, "code_challenge="+S256Challenge("verifier")) {
+		t.Fatalf("auth url %q does not carry the PKCE challenge", authURL)
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	mock := oidctest.New(t)
+	p := New(Config{
+		Issuer:   mock.Issuer + "/",
+		ClientID: oidctest.ClientID,
+	})
+	if _, err := p.AuthCodeURL(context.Background(), "s", "n", "v"); err == nil ||
+		!strings.Contains(err.Error(), "issuer mismatch") {
+		t.Fatalf("err = %v, want issuer mismatch", err)
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+	authURL, err := p.AuthCodeURL(ctx, "s", "n", "verifier")
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	code, _ := mock.Authorize(t, authURL, testUser)
+	if _, err := p.Exchange(ctx, code, "other-verifier"); err == nil {
+		t.Fatal("Exchange succeeded with a wrong PKCE verifier")
+	}
+}
+
+func TestExchangeRejectsReusedCode(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+	authURL, err := p.AuthCodeURL(ctx, "s", "n", "verifier")
+	if err != nil {
+		t.Fatalf("AuthCodeURL: %v", err)
+	}
+	code, _ := mock.Authorize(t, authURL, testUser)
+	if _, err := p.Exchange(ctx, code, "verifier"); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if _, err := p.Exchange(ctx, code, "verifier"); err == nil {
+		t.Fatal("Exchange succeeded twice with the same code")
+	}
+}
+
+func TestVerifyIDTokenNonceMismatch(t *testing.T) {
+	p, mock := newTestProvider(t)
+	idToken := login(t, p, mock, "nonce-1")
+	if _, err := p.VerifyIDToken(context.Background(), idToken, "nonce-2"); !errors.Is(err, ErrNonceMismatch) {
+		t.Fatalf("err = %v, want ErrNonceMismatch", err)
+	}
+}
+
+func TestVerifyIDTokenRejectsBadClaims(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+
+	cases := map[string]func(c map[string]any){
+		"issuer":   func(c map[string]any) { c["iss"] = "https://evil.example.com" },
+		"audience": func(c map[string]any) { c["aud"] = "other-client" },
+		"expired":  func(c map[string]any) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"no exp":   func(c map[string]any) { delete(c, "exp") },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			claims := mock.Claims(testUser, "n")
+			mutate(claims)
+			raw := mock.SignIDToken(t, "key-1", claims)
+			if _, err := p.VerifyIDToken(ctx, raw, "n"); err == nil {
+				t.Fatal("VerifyIDToken accepted the token")
+			}
+		})
+	}
+}
+
+func TestJWKSCachedAndRotated(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+
+	for range 3 {
+		raw := mock.SignIDToken(t, "key-1", mock.Claims(testUser, "n"))
+		if _, err := p.VerifyIDToken(ctx, raw, "n"); err != nil {
+			t.Fatalf("VerifyIDToken: %v", err)
+		}
+	}
+	if hits := mock.JWKSHits.Load(); hits != 1 {
+		t.Fatalf("jwks fetched %d times, want 1", hits)
+	}
+
+	// 密钥轮换后，超过最小刷新间隔的未知kid会触发一次刷新
+	mock.RotateKey(t, "key-2")
+	p.mu.Lock()
+	p.refreshedAt = time.Now().Add(-jwksMinRefresh)
+	p.mu.Unlock()
+	raw := mock.SignIDToken(t, "key-2", mock.Claims(testUser, "n"))
+	if _, err := p.VerifyIDToken(ctx, raw, "n"); err != nil {
+		t.Fatalf("VerifyIDToken after rotation: %v", err)
+	}
+	if hits := mock.JWKSHits.Load(); hits != 2 {
+		t.Fatalf("jwks fetched %d times, want 2", hits)
+	}
+}
+
+func TestUnknownKidRateLimited(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+
+	raw := mock.SignIDToken(t, "key-1", mock.Claims(testUser, "n"))
+	if _, err := p.VerifyIDToken(ctx, raw, "n"); err != nil {
+		t.Fatalf("VerifyIDToken: %v", err)
+	}
+	for i := range 10 {
+		raw := mock.SignIDToken(t, "forged-"+string(rune('a'+i)), mock.Claims(testUser, "n"))
+		if _, err := p.VerifyIDToken(ctx, raw, "n"); !errors.Is(err, ErrUnknownKey) {
+			t.Fatalf("err = %v, want ErrUnknownKey", err)
+		}
+	}
+	if hits := mock.JWKSHits.Load(); hits != 1 {
+		t.Fatalf("jwks fetched %d times for unknown kids, want 1", hits)
+	}
+}
+
+func TestConcurrentKeyFetch(t *testing.T) {
+	p, mock := newTestProvider(t)
+	ctx := context.Background()
+	raw := mock.SignIDToken(t, "key-1", mock.Claims(testUser, "n"))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.VerifyIDToken(ctx, raw, "n")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("VerifyIDToken: %v", err)
+		}
+	}
+	if hits := mock.JWKSHits.Load(); hits != 1 {
+		t.Fatalf("jwks fetched %d times, want 1", hits)
+	}
+}
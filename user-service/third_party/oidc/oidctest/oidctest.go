@@ -0,0 +1,239 @@
+// Package oidctest 提供基于httptest的OIDC身份提供方，用于测试授权码+PKCE流程
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ClientID     = "forum"
+	ClientSecret = "forum-secret"
+)
+
+// User 授权时模拟登录的第三方用户
+type User struct {
+	Subject           string
+	Email             string
+	PreferredUsername string
+}
+
+type grant struct {
+	challenge string
+	nonce     string
+	redirect  string
+	user      User
+}
+
+// Provider 模拟的身份提供方，提供discovery、jwks与token接口
+type Provider struct {
+	Server *httptest.Server
+	Issuer string
+
+	// JWKSHits jwks接口被请求的次数
+	JWKSHits atomic.Int32
+	// Nonce 非空时替换id_token中的nonce，用于测试nonce校验
+	Nonce string
+
+	mu     sync.Mutex
+	keys   map[string]*rsa.PrivateKey
+	kid    string
+	grants map[string]*grant
+}
+
+// New 启动模拟的身份提供方，测试结束时自动关闭
+func New(t testing.TB) *Provider {
+	t.Helper()
+	p := &Provider{
+		keys:   make(map[string]*rsa.PrivateKey),
+		grants: make(map[string]*grant),
+	}
+	p.RotateKey(t, "key-1")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("GET /jwks", p.handleJWKS)
+	mux.HandleFunc("POST /token", p.handleToken)
+	p.Server = httptest.NewServer(mux)
+	p.Issuer = p.Server.URL
+	t.Cleanup(p.Server.Close)
+	return p
+}
+
+// RotateKey 新增一个签名密钥并用它签发之后的id_token，旧密钥仍保留在jwks中
+func (p *Provider) RotateKey(t testing.TB, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[kid] = key
+	p.kid = kid
+}
+
+// Authorize 模拟用户在身份提供方同意授权，返回回调中携带的code与state
+func (p *Provider) Authorize(t testing.TB, authURL string, user User) (code, state string) {
+	t.Helper()
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("parse auth url: %v", err)
+	}
+	q := u.Query()
+	if got := q.Get("client_id"); got != ClientID {
+		t.Fatalf("client_id = %q, want %q", got, ClientID)
+	}
+	if got := q.Get("response_type"); got != "code" {
+		t.Fatalf("response_type = %q, want code", got)
+	}
+	if got := q.Get("code_challenge_method"); got != "S256" {
+		t.Fatalf("code_challenge_method = %q, want S256", got)
+	}
+	code = randomString()
+	p.mu.Lock()
+	p.grants[code] = &grant{
+		challenge: q.Get("code_challenge"),
+		nonce:     q.Get("nonce"),
+		redirect:  q.Get("redirect_uri"),
+		user:      user,
+	}
+	p.mu.Unlock()
+	return code, q.Get("state")
+}
+
+// SignIDToken 使用指定kid的密钥签发id_token，kid不存在时使用新生成的密钥
+func (p *Provider) SignIDToken(t testing.TB, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	p.mu.Lock()
+	key, ok := p.keys[kid]
+	p.mu.Unlock()
+	if !ok {
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			t.Fatalf("generate rsa key: %v", err)
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign id_token: %v", err)
+	}
+	return raw
+}
+
+// Claims 返回id_token的标准声明
+func (p *Provider) Claims(user User, nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":                p.Issuer,
+		"aud":                ClientID,
+		"sub":                user.Subject,
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Minute).Unix(),
+		"nonce":              nonce,
+		"email":              user.Email,
+		"preferred_username": user.PreferredUsername,
+	}
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 p.Issuer,
+		"authorization_endpoint": p.Issuer + "/authorize",
+		"token_endpoint":         p.Issuer + "/token",
+		"jwks_uri":               p.Issuer + "/jwks",
+	})
+}
+
+func (p *Provider) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	p.JWKSHits.Add(1)
+	p.mu.Lock()
+	keys := make([]map[string]string, 0, len(p.keys))
+	for kid, key := range p.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	p.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]any{"keys": keys})
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("client_id") != ClientID ||
+		r.PostForm.Get("client_secret") != ClientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	// code只能使用一次
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	g, ok := p.grants[code]
+	delete(p.grants, code)
+	key, kid, nonce := p.keys[p.kid], p.kid, p.Nonce
+	p.mu.Unlock()
+	if !ok || g.redirect != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	if nonce == "" {
+		nonce = g.nonce
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, p.Claims(g.user, nonce))
+	token.Header["kid"] = kid
+	idToken, err := token.SignedString(key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}